@@ -0,0 +1,29 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/shelmesky/bvm"
+	"github.com/shelmesky/bvm/runtime"
+)
+
+// printABI prints the descriptions of the contracts of the files or the directory in JSON
+func printABI(args []string) {
+	if len(args) == 0 {
+		printUsage()
+	}
+
+	vm := simvolio.NewVM(vmConfig)
+	contracts := load(vm, args)
+	list := make([]*runtime.ABI, len(contracts))
+	for i, cnt := range contracts {
+		list[i] = cnt.ABI()
+	}
+	out, err := json.MarshalIndent(list, ``, `  `)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(string(out))
+}
@@ -0,0 +1,36 @@
+package main
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+// dumpAST prints the syntax trees of the contracts in JSON
+func dumpAST(args []string) {
+	if len(args) == 0 {
+		printUsage()
+	}
+
+	var failed bool
+	for _, filename := range args {
+		root, err := parser.Parser(readSource(filename))
+		if err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			failed = true
+			continue
+		}
+		out, err := json.MarshalIndent(root, ``, `  `)
+		if err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			failed = true
+			continue
+		}
+		fmt.Println(string(out))
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
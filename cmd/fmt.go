@@ -0,0 +1,144 @@
+package main
+
+import (
+	"flag"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strings"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const diffContext = 3 // the count of unchanged lines around the changes
+
+// format prints the contracts in the canonical form. -w rewrites the files, -d prints
+// the differences between the files and the formatted sources.
+func format(args []string) {
+	flags := flag.NewFlagSet(`fmt`, flag.ExitOnError)
+	write := flags.Bool(`w`, false, `write the result to the source file`)
+	diff := flags.Bool(`d`, false, `display the differences`)
+	flags.Parse(args)
+	if flags.NArg() == 0 {
+		printUsage()
+	}
+
+	var failed bool
+	for _, filename := range flags.Args() {
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			fmt.Println(err)
+			failed = true
+			continue
+		}
+		out, err := parser.FormatSource(string(content))
+		if err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			failed = true
+			continue
+		}
+		switch {
+		case *write:
+			if out != string(content) {
+				if err = ioutil.WriteFile(filename, []byte(out), 0644); err != nil {
+					fmt.Println(err)
+					failed = true
+				}
+			}
+		case *diff:
+			fmt.Print(unifiedDiff(filename, string(content), out))
+		default:
+			fmt.Print(out)
+		}
+	}
+	if failed {
+		os.Exit(1)
+	}
+}
+
+// unifiedDiff returns the differences of the texts in the unified format
+func unifiedDiff(filename, before, after string) string {
+	if before == after {
+		return ``
+	}
+	a := strings.Split(strings.TrimSuffix(strings.Replace(before, "\r\n", "\n", -1), "\n"), "\n")
+	b := strings.Split(strings.TrimSuffix(after, "\n"), "\n")
+	// lcs[i][j] is the length of the longest common subsequence of a[i:] and b[j:]
+	lcs := make([][]int, len(a)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(b)+1)
+	}
+	for i := len(a) - 1; i >= 0; i-- {
+		for j := len(b) - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+	type diffLine struct {
+		Op   byte
+		Text string
+		A, B int // the indexes of the lines in a and b
+	}
+	var lines []diffLine
+	i, j := 0, 0
+	for i < len(a) || j < len(b) {
+		switch {
+		case i < len(a) && j < len(b) && a[i] == b[j]:
+			lines = append(lines, diffLine{' ', a[i], i, j})
+			i++
+			j++
+		case j == len(b) || (i < len(a) && lcs[i+1][j] >= lcs[i][j+1]):
+			lines = append(lines, diffLine{'-', a[i], i, j})
+			i++
+		default:
+			lines = append(lines, diffLine{'+', b[j], i, j})
+			j++
+		}
+	}
+	out := fmt.Sprintf("--- %s\n+++ %s\n", filename, filename)
+	for start := 0; start < len(lines); {
+		if lines[start].Op == ' ' {
+			start++
+			continue
+		}
+		// the hunk includes the changes which are closer than 2*diffContext lines
+		from, to := start-diffContext, start
+		if from < 0 {
+			from = 0
+		}
+		for unchanged := 0; to < len(lines) && unchanged <= 2*diffContext; to++ {
+			if lines[to].Op == ' ' {
+				unchanged++
+			} else {
+				unchanged = 0
+			}
+		}
+		for to > start && lines[to-1].Op == ' ' {
+			to--
+		}
+		end := to + diffContext
+		if end > len(lines) {
+			end = len(lines)
+		}
+		var countA, countB int
+		var body strings.Builder
+		for _, line := range lines[from:end] {
+			if line.Op != '+' {
+				countA++
+			}
+			if line.Op != '-' {
+				countB++
+			}
+			body.WriteString(string(line.Op) + line.Text + "\n")
+		}
+		out += fmt.Sprintf("@@ -%d,%d +%d,%d @@\n", lines[from].A+1, countA, lines[from].B+1, countB) +
+			body.String()
+		start = end
+	}
+	return out
+}
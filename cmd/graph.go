@@ -0,0 +1,41 @@
+package main
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/shelmesky/bvm"
+	"github.com/shelmesky/bvm/runtime"
+)
+
+// graph prints the graph of the contract calls in DOT format. The read contracts are
+// drawn with the dashed border, the pinned calls go to the nodes like Token.v2.
+func graph(args []string) {
+	if len(args) == 0 {
+		printUsage()
+	}
+
+	vm := simvolio.NewVM(vmConfig)
+	load(vm, args)
+	var contracts []*runtime.Contract
+	for i, cnt := range vm.Contracts {
+		// the pinned versions have the own indexes
+		if vm.NameSpace[cnt.Name] == uint32(i) {
+			contracts = append(contracts, cnt)
+		}
+	}
+	fmt.Println(`digraph contracts {`)
+	for _, cnt := range contracts {
+		if cnt.Read {
+			fmt.Printf("    %s [style=dashed];\n", strconv.Quote(cnt.Name))
+		} else {
+			fmt.Printf("    %s;\n", strconv.Quote(cnt.Name))
+		}
+	}
+	for _, cnt := range contracts {
+		for _, call := range cnt.Calls {
+			fmt.Printf("    %s -> %s;\n", strconv.Quote(cnt.Name), strconv.Quote(call))
+		}
+	}
+	fmt.Println(`}`)
+}
@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/shelmesky/bvm"
+)
+
+// lint prints the warnings of the static analyzer. The contracts of the previous files are loaded
+// so they can be called from the next files.
+func lint(args []string) {
+	if len(args) == 0 {
+		printUsage()
+	}
+
+	vm := simvolio.NewVM(vmConfig)
+	var count int
+	for i, filename := range args {
+		source := readSource(filename)
+		warnings, err := vm.Analyze(source)
+		if err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			count++
+			continue
+		}
+		for _, warning := range warnings {
+			fmt.Printf("%s: %s\n", filename, warning)
+		}
+		count += len(warnings)
+		if err = vm.LoadContract(source, int64(i)); err != nil {
+			fmt.Printf("%s: %v\n", filename, err)
+			count++
+		}
+	}
+	if count > 0 {
+		os.Exit(1)
+	}
+}
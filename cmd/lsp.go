@@ -0,0 +1,23 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	"github.com/shelmesky/bvm"
+	"github.com/shelmesky/bvm/lsp"
+)
+
+// serveLSP runs the language server over stdin and stdout. The contracts of the files
+// from the arguments are loaded before so they can be called from the opened documents.
+func serveLSP(args []string) {
+	vm := simvolio.NewVM(vmConfig)
+	for i, filename := range args {
+		if err := vm.LoadContract(readSource(filename), int64(i)); err != nil {
+			log.Printf("%s: %v", filename, err)
+		}
+	}
+	if err := lsp.NewServer(vm).Serve(os.Stdin, os.Stdout); err != nil {
+		log.Fatal("lsp failed:", err)
+	}
+}
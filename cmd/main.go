@@ -76,22 +76,13 @@ func (data myData) GetParam(name string) interface{} {
 }
 
 func printUsage() {
-	fmt.Printf("usage: %s [compile | run] filename\n", os.Args[0])
+	fmt.Printf("usage: %s [run | lint | lsp | fmt [-w | -d] | ast | graph | abi] filename... | directory\n", os.Args[0])
 	os.Exit(1)
 }
 
-func main() {
-
-	inputFilename := os.Args[1]
-
-	if len(inputFilename) == 0 {
-		fmt.Println("need filename")
-		os.Exit(1)
-	}
-
-	vm := simvolio.NewVM(vmConfig)
-
-	content, err := ioutil.ReadFile(inputFilename)
+// readSource reads the contract file, the lexer expects \r\n at the end of lines
+func readSource(filename string) string {
+	content, err := ioutil.ReadFile(filename)
 	if err != nil {
 		log.Fatal("ReadFile failed:", err)
 	}
@@ -100,15 +91,66 @@ func main() {
 	source := make([]string, 0, 32)
 
 	for _, line := range list {
-		source = append(source, line)
+		source = append(source, strings.TrimRight(line, "\r"))
 	}
 
-	contractBody := strings.Join(source, "\r\n")
+	return strings.Join(source, "\r\n")
+}
 
-	err = vm.LoadContract(contractBody, 0)
+func main() {
+	if len(os.Args) < 2 {
+		printUsage()
+	}
+
+	switch os.Args[1] {
+	case `run`:
+		run(os.Args[2:])
+	case `lint`:
+		lint(os.Args[2:])
+	case `lsp`:
+		serveLSP(os.Args[2:])
+	case `fmt`:
+		format(os.Args[2:])
+	case `graph`:
+		graph(os.Args[2:])
+	case `ast`:
+		dumpAST(os.Args[2:])
+	case `abi`:
+		printABI(os.Args[2:])
+	default:
+		run(os.Args[1:])
+	}
+}
+
+// load compiles and links the contracts of the files or the directory
+func load(vm *simvolio.VM, args []string) []*runtime.Contract {
+	var (
+		contracts []*runtime.Contract
+		err       error
+	)
+	if info, errStat := os.Stat(args[0]); errStat == nil && info.IsDir() {
+		contracts, err = vm.LoadDir(args[0])
+	} else {
+		contracts, err = vm.LoadFiles(args...)
+	}
 	if err != nil {
-		log.Fatal("LoadContract failed:", err)
+		log.Fatal("Load failed:", err)
 	}
+	if len(contracts) == 0 {
+		log.Fatal("no contracts in ", args[0])
+	}
+	return contracts
+}
+
+// run compiles the contracts of the files or the directory and executes the first contract
+func run(args []string) {
+	if len(args) == 0 || len(args[0]) == 0 {
+		fmt.Println("need filename")
+		os.Exit(1)
+	}
+
+	vm := simvolio.NewVM(vmConfig)
+	contracts := load(vm, args)
 
 	// 指定给合约的参数， key是参数名称
 	data := myData{
@@ -133,7 +175,7 @@ func main() {
 		},
 	}
 
-	contract0 := vm.Contracts[0]
+	contract0 := contracts[0]
 
 	fmt.Println("")
 	result, gas, err := vm.Run(contract0, data)
@@ -330,7 +372,7 @@ func Run(bytecodeFilename string) {
 		},
 	}
 
-	contract0 := vm.Contracts[0]
+	contract0 := contracts[0]
 
 	result, gas, err := vm.Run(contract0, data)
 	if err != nil {
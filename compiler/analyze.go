@@ -0,0 +1,381 @@
+package compiler
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+const (
+	warnUnusedVar      = `Variable %s is declared but not used`
+	warnUnusedParam    = `Data parameter %s is not used`
+	warnUnreachable    = `Unreachable code`
+	warnFuncReturn     = `Function %s doesn't return a value on some paths`
+	warnEnvUnset       = `Environment variable $%s is read but unset`
+	warnShadowFunc     = `Function %s shadows the built-in function`
+	warnShadowVar      = `Variable %s shadows the function with the same name`
+	warnShadowOuter    = `Variable %s shadows the variable of the outer block`
+	warnConstCond      = `Condition is always %v`
+	warnDivZero        = `Possible division by zero`
+	warnReadDynamic    = `Mutable function %s can be called from the read contract through @%s`
+	warnReadDynamicCnt = `Mutable contract @%s can be called from the read contract through @%s`
+)
+
+// Warning is a message of the static analyzer which doesn't stop the compilation
+type Warning struct {
+	Contract string
+	Line     int
+	Column   uint32
+	Text     string
+}
+
+func (w Warning) String() string {
+	return fmt.Sprintf("%s %d:%d: %s", w.Contract, w.Line, w.Column, w.Text)
+}
+
+type varDecl struct {
+	Name  string
+	Node  *parser.Node
+	Param bool // the parameter of data section
+	Used  bool
+}
+
+// analysis collects the information about the contract during the compilation
+type analysis struct {
+	Warnings []Warning
+	Vars     map[string]*varDecl
+	Decls    []*varDecl
+	Calls    []*parser.Node // calls of other contracts
+}
+
+// Analyze compiles the contract and returns the warnings of the static analyzer.
+// The compiled contract is not returned and not linked.
+func Analyze(input string, nameSpace *map[string]uint32, contracts *[]*rt.Contract,
+	custom *rt.Custom) ([]Warning, error) {
+	_, warnings, err := AnalyzeContract(input, nameSpace, contracts, custom)
+	return warnings, err
+}
+
+// AnalyzeContract compiles the contract and returns it with the warnings of the static
+// analyzer. The compiled contract is not linked.
+func AnalyzeContract(input string, nameSpace *map[string]uint32, contracts *[]*rt.Contract,
+	custom *rt.Custom) (*rt.Contract, []Warning, error) {
+	cmpl := newCompiler(nameSpace, contracts, custom)
+	cmpl.Analysis = &analysis{
+		Vars: make(map[string]*varDecl),
+	}
+	if err := cmpl.compile(input); err != nil {
+		return nil, nil, err
+	}
+	for _, decl := range cmpl.Analysis.Decls {
+		if decl.Used {
+			continue
+		}
+		if decl.Param {
+			cmpl.warning(decl.Node, warnUnusedParam, decl.Name)
+		} else {
+			cmpl.warning(decl.Node, warnUnusedVar, decl.Name)
+		}
+	}
+	if cmpl.Contract.Read {
+		for _, call := range cmpl.Analysis.Calls {
+			nCall := call.Value.(*parser.NCallContract)
+			cmpl.checkReadCall(call, (*nameSpace)[parser.VersionName(nCall.Name, nCall.Version)],
+				make(map[uint32]bool))
+		}
+	}
+	warnings := cmpl.Analysis.Warnings
+	sort.SliceStable(warnings, func(i, j int) bool {
+		if warnings[i].Line == warnings[j].Line {
+			return warnings[i].Column < warnings[j].Column
+		}
+		return warnings[i].Line < warnings[j].Line
+	})
+	return cmpl.Contract, warnings, nil
+}
+
+func (cmpl *compiler) warning(node *parser.Node, text string, params ...interface{}) {
+	if len(params) > 0 {
+		text = fmt.Sprintf(text, params...)
+	}
+	cmpl.Analysis.Warnings = append(cmpl.Analysis.Warnings, Warning{
+		Contract: cmpl.Contract.Name,
+		Line:     node.Line,
+		Column:   node.Column,
+		Text:     text,
+	})
+}
+
+// declare registers a new variable
+func (cmpl *compiler) declare(node *parser.Node, name string) {
+	if cmpl.Analysis == nil {
+		return
+	}
+	decl := &varDecl{Name: name, Node: node}
+	cmpl.Analysis.Vars[name] = decl
+	cmpl.Analysis.Decls = append(cmpl.Analysis.Decls, decl)
+	if isBuiltin(cmpl, name) || cmpl.isLocalFunc(name) {
+		cmpl.warning(node, warnShadowVar, name)
+	}
+}
+
+// use marks the variable as read
+func (cmpl *compiler) use(name string) {
+	if cmpl.Analysis == nil {
+		return
+	}
+	if decl, ok := cmpl.Analysis.Vars[name]; ok {
+		decl.Used = true
+	}
+}
+
+func isBuiltin(cmpl *compiler, name string) bool {
+	for _, eFunc := range rt.StdLib {
+		if eFunc.Name == name {
+			return true
+		}
+	}
+	if cmpl.Custom != nil {
+		for _, fItem := range cmpl.Custom.Funcs {
+			if fItem.Name == name {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (cmpl *compiler) isLocalFunc(name string) bool {
+	for _, finfo := range cmpl.Contract.Funcs {
+		if finfo.Name == name {
+			return true
+		}
+	}
+	return false
+}
+
+// constValue returns the value of the expression if it consists of constants
+func constValue(node *parser.Node) (interface{}, bool) {
+	switch node.Type {
+	case parser.TValue:
+		return node.Value, true
+	case parser.TBinary:
+		nBinary := node.Value.(*parser.NBinary)
+		if left, ok := constValue(nBinary.Left); ok {
+			if right, ok := constValue(nBinary.Right); ok {
+				return foldBinary(nBinary.Oper, left, right)
+			}
+		}
+	case parser.TUnary:
+		nUnary := node.Value.(*parser.NUnary)
+		if operand, ok := constValue(nUnary.Operand); ok {
+			return foldUnary(nUnary.Oper, operand)
+		}
+	}
+	return nil, false
+}
+
+// hasReturn returns true if there is return statement inside the node
+func hasReturn(node *parser.Node) (ret bool) {
+	if node == nil {
+		return false
+	}
+	switch node.Type {
+	case parser.TReturn:
+		return true
+	case parser.TBlock:
+		for _, child := range node.Value.(*parser.NBlock).Statements {
+			ret = ret || hasReturn(child)
+		}
+	case parser.TIf:
+		nIf := node.Value.(*parser.NIf)
+		ret = hasReturn(nIf.IfBody) || hasReturn(nIf.ElseBody)
+		if nIf.ElifBody != nil {
+			for _, child := range nIf.ElifBody.Value.(*parser.NElif).List {
+				ret = ret || hasReturn(child.Body)
+			}
+		}
+	case parser.TSwitch:
+		nSwitch := node.Value.(*parser.NSwitch)
+		for _, icase := range nSwitch.Case.Value.(*parser.NCase).List {
+			ret = ret || hasReturn(icase.Body)
+		}
+		ret = ret || hasReturn(nSwitch.Default)
+	case parser.TWhile:
+		ret = hasReturn(node.Value.(*parser.NWhile).Body)
+	case parser.TFor:
+		ret = hasReturn(node.Value.(*parser.NFor).Body)
+	case parser.TForInt:
+		ret = hasReturn(node.Value.(*parser.NForInt).Body)
+	}
+	return
+}
+
+// terminates returns true if the statement returns on all paths
+func terminates(node *parser.Node) bool {
+	if node == nil {
+		return false
+	}
+	switch node.Type {
+	case parser.TReturn:
+		return true
+	case parser.TBlock:
+		for _, child := range node.Value.(*parser.NBlock).Statements {
+			if terminates(child) {
+				return true
+			}
+		}
+	case parser.TIf:
+		nIf := node.Value.(*parser.NIf)
+		if !terminates(nIf.IfBody) || !terminates(nIf.ElseBody) {
+			return false
+		}
+		if nIf.ElifBody != nil {
+			for _, child := range nIf.ElifBody.Value.(*parser.NElif).List {
+				if !terminates(child.Body) {
+					return false
+				}
+			}
+		}
+		return true
+	case parser.TSwitch:
+		nSwitch := node.Value.(*parser.NSwitch)
+		for _, icase := range nSwitch.Case.Value.(*parser.NCase).List {
+			if !terminates(icase.Body) {
+				return false
+			}
+		}
+		return terminates(nSwitch.Default)
+	case parser.TWhile:
+		// the endless loop without break never leaves the function
+		nWhile := node.Value.(*parser.NWhile)
+		if v, ok := constValue(nWhile.Cond); ok && v == true {
+			return !hasBreakLoop(nWhile.Body)
+		}
+	}
+	return false
+}
+
+// hasBreakLoop returns true if there is break statement which leaves the current loop
+func hasBreakLoop(node *parser.Node) bool {
+	if node == nil {
+		return false
+	}
+	switch node.Type {
+	case parser.TBreak:
+		return true
+	case parser.TBlock:
+		for _, child := range node.Value.(*parser.NBlock).Statements {
+			if hasBreakLoop(child) {
+				return true
+			}
+		}
+	case parser.TIf:
+		nIf := node.Value.(*parser.NIf)
+		if hasBreakLoop(nIf.IfBody) || hasBreakLoop(nIf.ElseBody) {
+			return true
+		}
+		if nIf.ElifBody != nil {
+			for _, child := range nIf.ElifBody.Value.(*parser.NElif).List {
+				if hasBreakLoop(child.Body) {
+					return true
+				}
+			}
+		}
+	case parser.TSwitch:
+		nSwitch := node.Value.(*parser.NSwitch)
+		for _, icase := range nSwitch.Case.Value.(*parser.NCase).List {
+			if hasBreakLoop(icase.Body) {
+				return true
+			}
+		}
+		return hasBreakLoop(nSwitch.Default)
+	}
+	return false
+}
+
+// checkCond reports the constant conditions of if and while. loop is the body of while.
+func (cmpl *compiler) checkCond(node *parser.Node, loop *parser.Node) {
+	if cmpl.Analysis == nil {
+		return
+	}
+	v, ok := constValue(node)
+	if !ok {
+		return
+	}
+	if v == true && loop != nil && (hasBreakLoop(loop) || hasReturn(loop)) {
+		// while true { ... break } is a usual loop
+		return
+	}
+	cmpl.warning(node, warnConstCond, v)
+}
+
+// checkDivZero reports the division by the literal zero
+func (cmpl *compiler) checkDivZero(node *parser.Node) {
+	if cmpl.Analysis == nil {
+		return
+	}
+	nBinary := node.Value.(*parser.NBinary)
+	switch nBinary.Oper {
+	case parser.DIV, parser.MOD, parser.DIV_ASSIGN, parser.MOD_ASSIGN:
+	default:
+		return
+	}
+	if v, ok := constValue(nBinary.Right); ok && (v == int64(0) || v == float64(0)) {
+		cmpl.warning(node, warnDivZero)
+	}
+}
+
+// checkUnreachable reports the statements after return, break and continue
+func (cmpl *compiler) checkUnreachable(list []*parser.Node) {
+	if cmpl.Analysis == nil {
+		return
+	}
+	for i, stmt := range list {
+		if stmt.Type != parser.TReturn && stmt.Type != parser.TBreak && stmt.Type != parser.TContinue {
+			continue
+		}
+		// the end of for loop is added by the compiler
+		if i+1 < len(list) && list[i+1].Type != parser.TEndLabel {
+			cmpl.warning(list[i+1], warnUnreachable)
+		}
+		return
+	}
+}
+
+// checkReadCall reports the mutable functions and contracts which can be called from the read
+// contract through the called contracts. They can be replaced after the compilation of the contract.
+func (cmpl *compiler) checkReadCall(node *parser.Node, ind uint32, visited map[uint32]bool) {
+	if visited[ind] || int(ind) >= len(*cmpl.Contracts) {
+		return
+	}
+	visited[ind] = true
+	cnt := (*cmpl.Contracts)[ind]
+	list, ok := decode(cnt.Code, make(map[int]*instr))
+	if !ok {
+		return
+	}
+	nCall := node.Value.(*parser.NCallContract)
+	name := parser.VersionName(nCall.Name, nCall.Version)
+	for _, item := range list {
+		if len(item.Code) == 0 {
+			continue
+		}
+		switch item.Code[0] {
+		case rt.CUSTOMFUNC:
+			if cmpl.Custom != nil && int(item.Code[1]) < len(cmpl.Custom.Funcs) &&
+				!cmpl.Custom.Funcs[item.Code[1]].Read {
+				cmpl.warning(node, warnReadDynamic, cmpl.Custom.Funcs[item.Code[1]].Name, name)
+			}
+		case rt.CALLCONTRACT:
+			callee := uint32(item.Code[1])
+			if int(callee) < len(*cmpl.Contracts) && !(*cmpl.Contracts)[callee].Read {
+				cmpl.warning(node, warnReadDynamicCnt, (*cmpl.Contracts)[callee].Name, name)
+				continue
+			}
+			cmpl.checkReadCall(node, callee, visited)
+		}
+	}
+}
@@ -17,18 +17,37 @@ const (
 type jumps struct {
 	Breaks    []int
 	Continues []int
+	Tries     int // the count of try blocks outside of the loop
+}
+
+// scope is the variables of the block. The variables of the inner block are removed at the end
+// of the block and the variables of the outer blocks which they shadow are restored.
+type scope struct {
+	Start    int // the count of the variables before the block
+	Shadowed map[string]rt.VarInfo
+	Decls    map[string]*varDecl // the shadowed declarations of the static analyzer
 }
 
 type compiler struct {
 	Contract  *rt.Contract
 	Blocks    []*parser.Node
+	Scopes    []*scope
 	Contracts *[]*rt.Contract
 	Custom    *rt.Custom
 	NameSpace *map[string]uint32
 	RetFunc   int64
 	InFunc    bool
+	Func      *rt.FuncInfo // the function which is being compiled
+	Tuple     bool         // the function call can return multiple values
+	Mutable   map[*rt.FuncInfo]bool
+	ReadOnly  bool // the code before the end of the conditions section cannot change the state
+	InCond    bool // the conditions section is being compiled
+	Action    bool // the action section has been compiled
+	Tries     int  // the count of the nested try blocks
 	Data      []byte
 	Jumps     []*jumps
+	Analysis  *analysis              // the information for the static analyzer, nil if it is not used
+	Bounds    map[*parser.Node]int64 // the count of iterations of the loops with constant bounds
 }
 
 func DebugPrintf(formatString string, a ...interface{}) {
@@ -58,6 +77,42 @@ func (cmpl *compiler) Append(codes ...rt.Bcode) {
 	}
 }
 
+// position saves the position of the integer operation which can overflow. It must be called
+// right before appending the operation.
+func (cmpl *compiler) position(node *parser.Node, code rt.Bcode) {
+	switch code {
+	case rt.ADDINT, rt.SUBINT, rt.MULINT, rt.DIVINT, rt.SIGNINT, rt.ASSIGNADDINT,
+		rt.ASSIGNSUBINT, rt.ASSIGNMULINT, rt.ASSIGNDIVINT, rt.SHLINT, rt.ASSIGNSHLINT:
+		cmpl.Contract.Positions = append(cmpl.Contract.Positions, rt.Position{
+			Offset: len(cmpl.Contract.Code), Line: node.Line, Column: node.Column})
+	}
+}
+
+// truncate removes the code after size, the code has been compiled only for checking the types
+func (cmpl *compiler) truncate(size int) {
+	cmpl.Contract.Code = cmpl.Contract.Code[:size]
+	positions := cmpl.Contract.Positions
+	for len(positions) > 0 && positions[len(positions)-1].Offset >= size {
+		positions = positions[:len(positions)-1]
+	}
+	cmpl.Contract.Positions = positions
+}
+
+// optimize returns true if the optimization passes are enabled
+func (cmpl *compiler) optimize() bool {
+	return cmpl.Custom != nil && cmpl.Custom.Optimize
+}
+
+// addCall saves the name of the called contract for the dependency graph
+func (cmpl *compiler) addCall(name string) {
+	for _, item := range cmpl.Contract.Calls {
+		if item == name {
+			return
+		}
+	}
+	cmpl.Contract.Calls = append(cmpl.Contract.Calls, name)
+}
+
 func (cmpl *compiler) JumpOff(node *parser.Node, off int) (rt.Bcode, error) {
 	if off < math.MinInt16 || off > math.MaxInt16 {
 		return rt.NOP, cmpl.Error(node, errJump)
@@ -93,8 +148,10 @@ func (cmpl *compiler) InitVars(node *parser.Node, vars []parser.NVar) ([]rt.Bcod
 	}
 	types := make([]rt.Bcode, len(vars))
 	for i, v := range vars {
-		if _, ok := cmpl.Contract.Vars[v.Name]; ok {
-			return idxList, cmpl.ErrorParam(node, errVarExists, v.Name)
+		if prev, ok := cmpl.Contract.Vars[v.Name]; ok {
+			if err := cmpl.shadow(node, v, prev); err != nil {
+				return idxList, err
+			}
 		}
 		vType := v.Type.Value.(*parser.NType).Type
 		if vType == parser.VVoid {
@@ -102,14 +159,15 @@ func (cmpl *compiler) InitVars(node *parser.Node, vars []parser.NVar) ([]rt.Bcod
 		}
 		types[i] = rt.Bcode(vType)
 
-		idx := uint16(len(cmpl.Contract.Vars))
+		idx := uint16(len(cmpl.Contract.VarsList))
 		idxList = append(idxList, rt.Bcode(idx))
 		rtInfo := rt.VarInfo{
 			Index: idx,
-			Type:  uint16(vType),
+			Type:  uint32(vType),
 		}
 		cmpl.Contract.Vars[v.Name] = rtInfo
 		cmpl.Contract.VarsList = append(cmpl.Contract.VarsList, rtInfo)
+		cmpl.declare(v.Type, v.Name)
 	}
 
 	// 不生成INITVARS指令，即不依靠INITVARS指令建立变量的符号表
@@ -126,6 +184,45 @@ func (cmpl *compiler) InitVars(node *parser.Node, vars []parser.NVar) ([]rt.Bcod
 	return idxList, nil
 }
 
+// shadow checks the variable with the name of the declared variable. The variable of the inner
+// block can shadow the variable of the outer block, it is restored at the end of the block.
+func (cmpl *compiler) shadow(node *parser.Node, v parser.NVar, prev rt.VarInfo) error {
+	if len(cmpl.Scopes) == 0 || int(prev.Index) >= cmpl.Scopes[len(cmpl.Scopes)-1].Start {
+		return cmpl.ErrorParam(node, errVarExists, v.Name)
+	}
+	top := cmpl.Scopes[len(cmpl.Scopes)-1]
+	if top.Shadowed == nil {
+		top.Shadowed = make(map[string]rt.VarInfo)
+		top.Decls = make(map[string]*varDecl)
+	}
+	top.Shadowed[v.Name] = prev
+	if cmpl.Analysis != nil {
+		top.Decls[v.Name] = cmpl.Analysis.Vars[v.Name]
+		cmpl.warning(v.Type, warnShadowOuter, v.Name)
+	}
+	return nil
+}
+
+// closeScope removes the variables of the inner block and restores the shadowed variables
+func (cmpl *compiler) closeScope() {
+	top := cmpl.Scopes[len(cmpl.Scopes)-1]
+	cmpl.Scopes = cmpl.Scopes[:len(cmpl.Scopes)-1]
+	for name, vinfo := range cmpl.Contract.Vars {
+		if int(vinfo.Index) >= top.Start {
+			delete(cmpl.Contract.Vars, name)
+			if cmpl.Analysis != nil {
+				delete(cmpl.Analysis.Vars, name)
+			}
+		}
+	}
+	for name, vinfo := range top.Shadowed {
+		cmpl.Contract.Vars[name] = vinfo
+		if decl := top.Decls[name]; decl != nil {
+			cmpl.Analysis.Vars[name] = decl
+		}
+	}
+}
+
 func nodeToCode(node *parser.Node, cmpl *compiler) error {
 	var (
 		err                error
@@ -147,9 +244,9 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			如果类型是合约， 则其中的data{}会被当作Block的参数.
 			如果类型是函数， 进入到这里表明已经在处理函数体， 而函数的参数和返回值在TFunc类型中已经被处理。
 		*/
-		//varsCount := uint16(len(cmpl.Contract.Vars)) // 当前合约内所有的变量(var声明和函数参数)
 		funcsCount := len(cmpl.Contract.Funcs) // 当前合约的所有函数
 		cmpl.Blocks = append(cmpl.Blocks, node)
+		cmpl.Scopes = append(cmpl.Scopes, &scope{Start: len(cmpl.Contract.VarsList)})
 		pars := node.Value.(*parser.NBlock).Params // 当前Block代码的参数数量
 		// 如果参数数量大于0 (进入到次分支， 说明正在编译contract的data结构。函数的Block不会进入此分支.
 		// 因为函数的参数已经在TFunc类型中处理。
@@ -160,11 +257,20 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			cmpl.Contract.Params = make(map[string]rt.VarInfo) // 初始化contract的参数
 			for k, ipar := range pars {                        // 将每个参数保存在编译结果的Contract.Params这个map中
 				cmpl.Contract.Params[ipar.Name] = rt.VarInfo{Index: uint16(k),
-					Type: uint16(ipar.Type.Value.(*parser.NType).Type)}
+					Type: uint32(ipar.Type.Value.(*parser.NType).Type)}
+				if cmpl.Analysis != nil {
+					cmpl.Analysis.Vars[ipar.Name].Param = true
+				}
+				if len(ipar.Attr) > 0 { // 参数的属性，例如 "required,min=0"
+					if err = cmpl.setAttr(ipar); err != nil {
+						return err
+					}
+				}
 			}
 			cmpl.Append(rt.LOADPARS) // 生成LOADPARS指令
 		}
 
+		cmpl.checkUnreachable(node.Value.(*parser.NBlock).Statements)
 		for _, child := range node.Value.(*parser.NBlock).Statements { // 编译block中的语句
 			if err = nodeToCode(child, cmpl); err != nil {
 				return err
@@ -173,23 +279,15 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 
 		cmpl.Blocks = cmpl.Blocks[:len(cmpl.Blocks)-1]
 
-		//if uint16(len(cmpl.Contract.Vars)) != varsCount &&
-		//	cmpl.Contract.Code[len(cmpl.Contract.Code)-1] != rt.RETFUNC {
-		//	cmpl.Append(rt.DELVARS, rt.Bcode(varsCount))
-		//}
-
-		//vinfo.Index >= varsCount说明在进入Block编译后，比进入Block之前多了很多变量，这些变量是Block内的变量
-		//应该在contract.Vars中删除这些局部变量?
-		// 只是为了确保局部变量名称不和全局变量冲突，可以不删除，只要确保不重名即可
-		// Remove vars
-
-		//for key, _ := range cmpl.Contract.Vars {
-		//	if vinfo.Index >= varsCount {
-		//		delete(cmpl.Contract.Vars, key)
-		//	}
-		//}
+		// 内部代码块的变量在代码块结束后被删除，变量的索引不变。合约顶层的变量保留在Contract.Vars中
+		if len(cmpl.Blocks) > 0 {
+			cmpl.closeScope()
+		} else {
+			cmpl.Scopes = cmpl.Scopes[:len(cmpl.Scopes)-1]
+		}
 
-		if funcsCount < len(cmpl.Contract.Funcs) {
+		// 合约顶层的函数保留在Contract.Funcs中(用于ABI)， 它们的命名空间键在compileNode中删除
+		if funcsCount < len(cmpl.Contract.Funcs) && len(cmpl.Blocks) > 0 {
 			// Remove funcs
 			for i := funcsCount; i < len(cmpl.Contract.Funcs); i++ {
 				delete(*cmpl.NameSpace, getFuncKey(cmpl.Contract.Funcs[i]))
@@ -200,18 +298,35 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 	case parser.TContract: // contract关键字
 		cmpl.Contract.Name = node.Value.(*parser.NContract).Name
 		cmpl.Contract.Read = node.Value.(*parser.NContract).Read
+		if cmpl.Contract.Library = node.Value.(*parser.NContract).Library; cmpl.Contract.Library {
+			if err = cmpl.checkLibrary(node); err != nil {
+				return err
+			}
+		}
+		cmpl.ReadOnly = hasConditions(node)
 		if err = nodeToCode(node.Value.(*parser.NContract).Block, cmpl); err != nil {
 			return err
 		}
 	case parser.TReturn: // return关键字
 		var vtype uint32
-		expr := node.Value.(*parser.NReturn).Expr
+		nReturn := node.Value.(*parser.NReturn)
+		if len(nReturn.List) > 0 || (cmpl.InFunc && len(cmpl.Func.Results) > 0) {
+			// 返回多个值
+			if err = cmpl.returnList(node); err != nil {
+				return err
+			}
+			break
+		}
+		expr := nReturn.Expr
 		if expr != nil { // 如果return含有表达式
 			if err = nodeToCode(expr, cmpl); err != nil {
 				return err
 			}
 			vtype = expr.Result
 		}
+		if cmpl.InCond && !cmpl.InFunc {
+			return cmpl.Error(node, errCondReturn)
+		}
 		if cmpl.InFunc { // 如果当前在函数中
 			if vtype != uint32(cmpl.RetFunc) {
 				if cmpl.RetFunc == parser.VVoid { // 如果函数的返回类型是空
@@ -228,6 +343,28 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		}
 	case parser.TBinary:
 		nBinary := node.Value.(*parser.NBinary)
+		if nBinary.Left.Type == parser.TField && nBinary.Oper == parser.ASSIGN {
+			// xxx.yyy = 表达式
+			if err = cmpl.setField(node); err != nil {
+				return err
+			}
+			break
+		}
+		switch nBinary.Oper {
+		case parser.INC: // x++ 等同于 x += 1
+			nBinary.Oper = parser.ADD_ASSIGN
+		case parser.DEC:
+			nBinary.Oper = parser.SUB_ASSIGN
+		}
+		cmpl.checkDivZero(node)
+		if nBinary.Left.Type == parser.TGetIndex && nBinary.Oper != parser.ASSIGN &&
+			isAssign(nBinary.Oper) {
+			// xxx[yyy] += 表达式
+			if err = cmpl.assignIndex(node); err != nil {
+				return err
+			}
+			break
+		}
 		if nBinary.Left.Type == parser.TGetIndex && nBinary.Oper == parser.ASSIGN {
 			nBinary.Left.Type = parser.TSetIndex
 		}
@@ -271,7 +408,11 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		case rt.OR:
 			jumpCmd = rt.Bcode(rt.JNZ)
 		}
+		cmpl.position(node, code)
 		cmpl.Append(code)
+		if code == rt.EQDEEP {
+			cmpl.Append(rt.Bcode(nBinary.Left.Result))
+		}
 		if notCmp {
 			cmpl.Append(rt.NOT)
 		}
@@ -279,6 +420,11 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			cmpl.Contract.Code = append(cmpl.Contract.Code[:forJump],
 				append([]rt.Bcode{rt.DUP, jumpCmd, rt.Bcode(len(cmpl.Contract.Code) - forJump + 2)},
 					cmpl.Contract.Code[forJump:]...)...)
+			for i := range cmpl.Contract.Positions {
+				if cmpl.Contract.Positions[i].Offset >= forJump {
+					cmpl.Contract.Positions[i].Offset += 3
+				}
+			}
 		}
 		node.Result = result
 	case parser.TUnary:
@@ -290,6 +436,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if code == rt.NOP {
 			return cmpl.ErrorOperator(node)
 		}
+		cmpl.position(node, code)
 		cmpl.Append(code)
 		node.Result = result
 	case parser.TQuestion:
@@ -325,9 +472,10 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 	case parser.TValue: // 某种类型的字面值，例如123, "abc", 0.12等
 		switch v := node.Value.(type) {
 		case int64:
-			if v <= math.MaxInt16 && v >= math.MinInt16 {
+			// PUSH16 and PUSH32 don't extend the sign so negative values go to PUSH64
+			if v <= math.MaxInt16 && v >= 0 {
 				cmpl.Append(rt.PUSH16, rt.Bcode(v))
-			} else if v <= math.MaxInt32 && v >= math.MinInt32 {
+			} else if v <= math.MaxUint32 && v >= 0 {
 				u32 := uint32(v)
 				cmpl.Append(rt.PUSH32, rt.Bcode(u32>>16), rt.Bcode(u32&0xffff))
 			} else {
@@ -369,6 +517,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if vinfo, ok = cmpl.Contract.Vars[name]; !ok {
 			return cmpl.ErrorParam(node, errVarUnknown, name)
 		}
+		cmpl.use(name)
 		cmpl.Append(rt.GETVAR, rt.Bcode(vinfo.Index))
 		node.Result = uint32(vinfo.Type)
 
@@ -382,11 +531,22 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 
 	case parser.TWhile:
 		nWhile := node.Value.(*parser.NWhile)
-		cmpl.Jumps = append(cmpl.Jumps, &jumps{})
+		cmpl.checkCond(nWhile.Cond, nWhile.Body)
+		cmpl.Jumps = append(cmpl.Jumps, &jumps{Tries: cmpl.Tries})
 		sizeCode, sizeCond, err = cmpl.ConditionCode(nWhile.Cond)
 		if err != nil {
 			return err
 		}
+		count, ok := cmpl.Bounds[node]
+		if !ok {
+			count = -1
+		}
+		// the loop is reported at the for or while keyword
+		loop := rt.LoopInfo{Offset: sizeCode, Count: count, Line: node.Line, Column: node.Column}
+		if pos := node.Pos(); pos.Line > 0 {
+			loop.Line, loop.Column = pos.Line, uint32(pos.Column)
+		}
+		cmpl.Contract.Loops = append(cmpl.Contract.Loops, loop)
 		cmpl.Append(rt.JZE, 0)
 		if err = nodeToCode(nWhile.Body, cmpl); err != nil {
 			return err
@@ -411,6 +571,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 	case parser.TIf:
 		ends := make([]int, 0, 16)
 		nIf := node.Value.(*parser.NIf)
+		cmpl.checkCond(nIf.Cond, nil)
 		_, sizeCond, err = cmpl.ConditionCode(nIf.Cond)
 		if err != nil {
 			return err
@@ -429,6 +590,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if nIf.ElifBody != nil {
 			nElif := nIf.ElifBody.Value.(*parser.NElif)
 			for _, child := range nElif.List {
+				cmpl.checkCond(child.Cond, nil)
 				_, sizeCond, err = cmpl.ConditionCode(child.Cond)
 				if err != nil {
 					return err
@@ -474,6 +636,9 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			Result: retType,                           // 返回类型
 			Params: make([]rt.Var, len(nFunc.Params)), // 参数列表
 		}
+		if finfo.Results, err = cmpl.funcResults(nFunc); err != nil { // 多个返回值的类型
+			return err
+		}
 		for ipar, v := range nFunc.Params { // 依次获取每个参数的类型和名称
 			finfo.Params[ipar] = rt.Var{Type: v.Type.Value.(*parser.NType).Type, Name: v.Name}
 		}
@@ -486,11 +651,15 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if code, _ := cmpl.findFunc(finfo); code != rt.NOP {
 			return cmpl.ErrorParam(node, errFuncExists, nFunc.Name)
 		}
+		if cmpl.Analysis != nil && isBuiltin(cmpl, nFunc.Name) {
+			cmpl.warning(node, warnShadowFunc, nFunc.Name)
+		}
 
 		start := len(cmpl.Contract.Code) // 目前合约所有的代码
 		cmpl.Append(rt.JMP, 0)           // 在代码中插入JMP, 0指令
 		finfo.Offset = start + 2         // 函数代码在
 		cmpl.InFunc = true               // 设置"在函数中"标志为true
+		cmpl.Func = finfo
 
 		// 初始化函数参数: 在code数组中插入[INITVARS, 类型长度，类型列表]
 		// 为函数调用前做准备
@@ -503,6 +672,10 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		// 这个指令会在函数执行之前从栈中获取每个参数的值，将这些值复制给之前初始化的参数
 		// idxList是变量在编译和运行时的索引，编译和运行时索引要保持一致
 		if len(nFunc.Params) > 0 {
+			// GETPARAMS从栈顶开始取值，所以最后一个参数在最前面
+			for i, j := 0, len(idxList)-1; i < j; i, j = i+1, j-1 {
+				idxList[i], idxList[j] = idxList[j], idxList[i]
+			}
 			cmpl.Append(rt.GETPARAMS, rt.Bcode(len(nFunc.Params)))
 			cmpl.Append(idxList...)
 		}
@@ -514,11 +687,16 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		cmpl.InFunc = false // 设置在函数中标志为false
 
 		// 如果函数最后的指令不是RETFUNC，且函数类型不是Void则报错
-		if cmpl.Contract.Code[len(cmpl.Contract.Code)-1] != rt.RETFUNC {
-			if cmpl.RetFunc != parser.VVoid {
+		last := cmpl.Contract.Code[len(cmpl.Contract.Code)-1]
+		if cmpl.RetFunc != parser.VVoid || len(finfo.Results) > 0 {
+			if last != rt.RETFUNC {
 				return cmpl.Error(node, errFuncReturn)
 			}
-			// 函数最后没有return关键字，则强行插入RETFUNC指令
+			if cmpl.Analysis != nil && !terminates(nFunc.Body) {
+				cmpl.warning(node, warnFuncReturn, nFunc.Name)
+			}
+		} else if last != rt.RETFUNC || !terminates(nFunc.Body) {
+			// 函数最后没有return关键字，或者最后的return只在某个分支中，则强行插入RETFUNC指令
 			cmpl.Append(rt.RETFUNC)
 		}
 
@@ -537,7 +715,18 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 
 	case parser.TCallFunc: // 函数调用
 		nFunc := node.Value.(*parser.NCallFunc) // 函数名
-		if nFunc.Params != nil {                //如果调用时有参数，则编译参数
+		// 只有多重赋值的函数调用可以返回多个值，参数中的调用不可以
+		tuple := cmpl.Tuple
+		cmpl.Tuple = false
+		switch nFunc.Name {
+		case `IsSet`:
+			return cmpl.isSet(node)
+		case `error`:
+			return cmpl.throw(node)
+		case `Sprintf`:
+			return cmpl.sprintf(node)
+		}
+		if nFunc.Params != nil { //如果调用时有参数，则编译参数
 			for _, expr := range nFunc.Params.Value.(*parser.NParams).Expr {
 				if err = nodeToCode(expr, cmpl); err != nil {
 					return err
@@ -552,6 +741,9 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 					pars = append(pars, Type2Str(uint32(par.Result)))
 				}
 			}
+			if lib := libraryName(nFunc.Name); len(lib) > 0 && !cmpl.imported(lib) {
+				return cmpl.ErrorParam(node, errNotImported, lib)
+			}
 			return cmpl.ErrorParam(node, errFuncNotExists, fmt.Sprintf("%s(%s)", nFunc.Name,
 				strings.Join(pars, `, `)))
 		}
@@ -562,10 +754,15 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			}
 			cmpl.Append(rt.CUSTOMFUNC, code-CUSTOM)
 
-			if cmpl.Contract.Read && !cmpl.Custom.Funcs[code-CUSTOM].Read {
-				return cmpl.Error(node, errReadContract)
+			if !cmpl.Custom.Funcs[code-CUSTOM].Read {
+				if err = cmpl.mutable(node); err != nil {
+					return err
+				}
 			}
 		} else if code >= EMBEDDED {
+			if err = cmpl.checkPattern(rt.StdLib[code-EMBEDDED], nFunc); err != nil {
+				return err
+			}
 			cmpl.Append(rt.EMBEDFUNC, code-EMBEDDED)
 		} else {
 			var off rt.Bcode
@@ -573,24 +770,74 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 				len(cmpl.Contract.Code)); err != nil {
 				return err
 			}
+			if len(cmpl.Contract.Funcs[code-1].Results) > 0 && !tuple {
+				return cmpl.ErrorParam(node, errMultiCall, nFunc.Name)
+			}
+			// the namespace has only 8 bits for the result so the struct types are taken here
+			node.Result = uint32(cmpl.Contract.Funcs[code-1].Result)
 			cmpl.Append(rt.CALLFUNC, off)
+			if cmpl.Mutable[cmpl.Contract.Funcs[code-1]] {
+				if err = cmpl.mutable(node); err != nil {
+					return err
+				}
+			}
+		}
+	case parser.TConditions, parser.TAction: // conditions和action部分
+		if err = cmpl.section(node); err != nil {
+			return err
+		}
+	case parser.TStruct: // 结构体已经在structs中声明
+	case parser.TStructValue: // Xxx{key1: 表达式, key2: 表达式}
+		if err = cmpl.structValue(node); err != nil {
+			return err
+		}
+	case parser.TSlice: // xxx[1:2]
+		if err = cmpl.slice(node); err != nil {
+			return err
+		}
+	case parser.TField: // xxx.yyy
+		if err = cmpl.getField(node); err != nil {
+			return err
+		}
+	case parser.TMultiAssign: // q, r = divmod(a, b)
+		if err = cmpl.multiAssign(node); err != nil {
+			return err
+		}
+	case parser.TTry: // try { } catch e { }
+		if err = cmpl.try(node); err != nil {
+			return err
+		}
+	case parser.TImport: // 导入库的函数
+		if err = cmpl.importLibrary(node); err != nil {
+			return err
 		}
 	case parser.TCallContract: // 调用其他合约
 		nCallContract := node.Value.(*parser.NCallContract)
 		// 在命名空间中根据合同名称寻找索引, 每编译好一个合约就在vm.Contracts中append，
 		// 拿到索引值后添加到vm.NameSpace中。(代码在vm.Link函数中)
 		// cmpl.NameSpace和cmpl.Contracts其实就是vm.NameSpace和vm.Contracts
-		ind, ok := (*cmpl.NameSpace)[nCallContract.Name]
+		// 指定版本的合约使用 name.vN 名称
+		name := parser.VersionName(nCallContract.Name, nCallContract.Version)
+		ind, ok := (*cmpl.NameSpace)[name]
 		if !ok {
-			return cmpl.ErrorParam(node, errContractNotExists, nCallContract.Name)
+			return cmpl.ErrorParam(node, errContractNotExists, name)
 		}
 		cnt := (*cmpl.Contracts)[ind] // 找到真正contract
-		if cmpl.Contract.Read && !cnt.Read {
-			return cmpl.Error(node, errReadContract)
+		if cnt.Library {
+			return cmpl.ErrorParam(node, errLibraryCall, name)
+		}
+		if !cnt.Read {
+			if err = cmpl.mutable(node); err != nil {
+				return err
+			}
 		}
+		if cmpl.Analysis != nil {
+			cmpl.Analysis.Calls = append(cmpl.Analysis.Calls, node)
+		}
+		cmpl.addCall(name)
 		if len(nCallContract.Params) > 0 { // 如果调用contract时指定了参数
 			if cnt.Params == nil { // 但如果真正的contract没有参数， 返回错误
-				return cmpl.ErrorParam(node, errContractNoParams, nCallContract.Name)
+				return cmpl.ErrorParam(node, errContractNoParams, name)
 			}
 			for _, ipar := range nCallContract.Params { // 循环处理每个调用时指定的参数
 				var (
@@ -609,6 +856,9 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 				cmpl.Append(rt.PARCONTRACT, rt.Bcode(vinfo.Index), rt.Bcode(vinfo.Type))
 			}
 		}
+		if err = cmpl.checkRequired(node, cnt); err != nil {
+			return err
+		}
 		cmpl.Append(rt.CALLCONTRACT, rt.Bcode(ind))
 		node.Result = parser.VStr
 	case parser.TGetIndex:
@@ -617,6 +867,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if vinfo, ok = cmpl.Contract.Vars[name]; !ok {
 			return cmpl.ErrorParam(node, errVarUnknown, name)
 		}
+		cmpl.use(name)
 		cmpl.Append(rt.GETVAR, rt.Bcode(vinfo.Index))
 		itype := uint32(vinfo.Type)
 		var outtype, subtype uint32
@@ -695,6 +946,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if len(cmpl.Jumps) == 0 {
 			return cmpl.Error(node, errBreak)
 		}
+		cmpl.leaveTries()
 		cmpl.Append(rt.JMP, 0)
 		cmpl.Jumps[len(cmpl.Jumps)-1].Breaks = append(cmpl.Jumps[len(cmpl.Jumps)-1].Breaks,
 			len(cmpl.Contract.Code)-1)
@@ -702,6 +954,7 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 		if len(cmpl.Jumps) == 0 {
 			return cmpl.Error(node, errContinue)
 		}
+		cmpl.leaveTries()
 		cmpl.Append(rt.JMP, 0)
 		cmpl.Jumps[len(cmpl.Jumps)-1].Continues = append(cmpl.Jumps[len(cmpl.Jumps)-1].Continues,
 			len(cmpl.Contract.Code)-1)
@@ -755,7 +1008,14 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 			return cmpl.ErrorParam(node, errEnv, nEnv.Name)
 		}
 		if val, ok = cmpl.Custom.Env[nEnv.Name]; !ok {
-			return cmpl.ErrorParam(node, errEnv, nEnv.Name)
+			if cmpl.Analysis == nil {
+				return cmpl.ErrorParam(node, errEnv, nEnv.Name)
+			}
+			// the analyzer continues the checking and supposes that the variable is int
+			cmpl.warning(node, warnEnvUnset, nEnv.Name)
+			cmpl.Append(rt.PUSH16, 0)
+			node.Result = parser.VInt
+			break
 		}
 		cmpl.Append(rt.ENV, rt.Bcode(val.Index))
 		node.Result = val.Type
@@ -869,8 +1129,24 @@ func nodeToCode(node *parser.Node, cmpl *compiler) error {
 */
 func Compile(input string, nameSpace *map[string]uint32, contracts *[]*rt.Contract,
 	custom *rt.Custom) (*rt.Contract, error) {
-	var root *parser.Node
+	cmpl := newCompiler(nameSpace, contracts, custom)
+	if err := cmpl.compile(input); err != nil {
+		return nil, err
+	}
+	return cmpl.Contract, nil
+}
+
+// CompileNode compiles AST of the contract which has been created by the parser
+func CompileNode(root *parser.Node, nameSpace *map[string]uint32, contracts *[]*rt.Contract,
+	custom *rt.Custom) (*rt.Contract, error) {
+	cmpl := newCompiler(nameSpace, contracts, custom)
+	if err := cmpl.compileNode(root); err != nil {
+		return nil, err
+	}
+	return cmpl.Contract, nil
+}
 
+func newCompiler(nameSpace *map[string]uint32, contracts *[]*rt.Contract, custom *rt.Custom) *compiler {
 	cmpl := &compiler{
 		Contract: &rt.Contract{
 			Code: make([]rt.Bcode, 0, 64),
@@ -884,23 +1160,43 @@ func Compile(input string, nameSpace *map[string]uint32, contracts *[]*rt.Contra
 	if len(*nameSpace) == 0 {
 		initNameSpace(cmpl, nameSpace)
 	}
+	return cmpl
+}
 
+func (cmpl *compiler) compile(input string) error {
 	root, err := parser.Parser(input)
 	if err != nil {
-		return nil, err
+		return err
 	}
+	if root.Value.(*parser.NContract).Library {
+		// the importing contracts compile the functions of the library from the source
+		cmpl.Contract.Source = input
+	}
+	return cmpl.compileNode(root)
+}
+
+func (cmpl *compiler) compileNode(root *parser.Node) error {
 	defer func() {
 		for i := 0; i < len(cmpl.Contract.Funcs); i++ {
 			delete(*cmpl.NameSpace, getFuncKey(cmpl.Contract.Funcs[i]))
 		}
 	}()
-	if err = nodeToCode(root, cmpl); err != nil {
-		return nil, err
+	if cmpl.optimize() {
+		optimizeTree(root)
+	}
+	if err := cmpl.structs(root); err != nil {
+		return err
+	}
+	if err := nodeToCode(root, cmpl); err != nil {
+		return err
+	}
+	if cmpl.optimize() {
+		optimizeCode(cmpl.Contract)
 	}
 	if len(cmpl.Data) > 0 {
 		length := len(cmpl.Data)
 		if length > 0xffff {
-			return nil, cmpl.Error(root, errData)
+			return cmpl.Error(root, errData)
 		}
 		if length&0x1 == 1 {
 			cmpl.Data = append(cmpl.Data, 0)
@@ -916,6 +1212,9 @@ func Compile(input string, nameSpace *map[string]uint32, contracts *[]*rt.Contra
 			off += 2
 		}
 		cmpl.Contract.Code = append(data, cmpl.Contract.Code...)
+		for i := range cmpl.Contract.Positions {
+			cmpl.Contract.Positions[i].Offset += len(data)
+		}
 	}
-	return cmpl.Contract, nil
+	return nil
 }
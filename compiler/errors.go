@@ -28,6 +28,7 @@ const (
 	errParamType         = `Unexpected type of the parameter; expecting %s`
 	errInvalidType       = `Invalid type`
 	errIndexType         = `Type %s doesn't support indexing`
+	errSliceType         = `Type %s doesn't support slicing`
 	errIndexInt          = `Unexpected type %s of expression; expecting int`
 	errIndexStr          = `Unexpected type %s of expression; expecting str`
 	errForType           = `Unexpected type %s of expression; expecting array, bytes or map`
@@ -38,10 +39,64 @@ const (
 	errSwitchType        = `switch doesn't support %s type`
 	errCaseType          = `Unexpected type %s of expression; expecting %s`
 	errReadContract      = `Calling mutable function or contract from the read contract`
+	errLibraryData       = `Library cannot have data parameters`
+	errLibraryStmt       = `Library can contain only functions and imports`
+	errLibraryNotExists  = `Library %s hasn't been found`
+	errLibraryCall       = `Library %s cannot be called as contract`
+	errNotLibrary        = `Contract %s is not a library`
+	errNotImported       = `Library %s hasn't been imported`
+	errImportLevel       = `Library must be imported at the top level of the contract`
+	errIsSetParam        = `IsSet requires the name of the data parameter`
+	errContractRequired  = `Contract %s requires %s parameter`
+	errSectionLevel      = `%s must be at the top level of the contract`
+	errSectionTwice      = `%s has already been defined`
+	errSectionOrder      = `conditions must be defined before action`
+	errCondReturn        = `return cannot be used in conditions`
+	errThrowParams       = `error requires code and message of str type`
+	errSprintfFormat     = `Sprintf requires the format of str type`
+	errReturnList        = `Contract can return only one value`
+	errResultCount       = `Function must return %d values`
+	errMultiCall         = `Function %s returns multiple values`
+	errMultiExpr         = `Multiple assignment requires the function call`
+	errMultiAssign       = `Assignment of %d variables but %s returns %d values`
+	errMultiType         = `Variable %s must have %s type`
+	errStructName        = `Struct %s must start with a capital letter`
+	errStructExists      = `Struct %s has already been defined`
+	errStructUnknown     = `Struct %s hasn't been defined`
+	errStructLevel       = `Struct must be defined at the top level of the contract`
+	errFieldExists       = `Field %s has already been defined`
+	errFieldUnknown      = `Struct %s doesn't have %s field`
+	errFieldType         = `Type %s doesn't have fields`
+	errFieldValue        = `Field %s must have %s type`
 )
 
+// Error is a compilation error with the position in the source
+type Error struct {
+	File     string // the name of the source file, it can be empty
+	Contract string
+	Line     int
+	Column   uint32
+	Text     string
+}
+
+func (e *Error) Error() string {
+	if len(e.File) > 0 {
+		return fmt.Sprintf("%s:%d:%d: %s: %s", e.File, e.Line, e.Column, e.Contract, e.Text)
+	}
+	return fmt.Sprintf("%s %d:%d: %s", e.Contract, e.Line, e.Column, e.Text)
+}
+
 func (cmpl *compiler) Error(node *parser.Node, text string) error {
-	return fmt.Errorf("%s %d:%d: %s", cmpl.Contract.Name, node.Line, node.Column, text)
+	return &Error{Contract: cmpl.Contract.Name, Line: node.Line, Column: node.Column, Text: text}
+}
+
+// ErrorBegin reports the error at the beginning of the node, it is used for the declarations
+func (cmpl *compiler) ErrorBegin(node *parser.Node, text string) error {
+	if pos := node.Pos(); pos.Line > 0 {
+		return &Error{Contract: cmpl.Contract.Name, Line: pos.Line, Column: uint32(pos.Column),
+			Text: text}
+	}
+	return cmpl.Error(node, text)
 }
 
 func (cmpl *compiler) ErrorParam(node *parser.Node, text string, value interface{}) error {
@@ -90,6 +145,28 @@ func (cmpl *compiler) ErrorOperator(node *parser.Node) error {
 		name = `/=`
 	case parser.MOD_ASSIGN:
 		name = `%=`
+	case parser.BIT_AND:
+		name = `&`
+	case parser.BIT_OR:
+		name = `|`
+	case parser.BIT_XOR:
+		name = `^`
+	case parser.BIT_NOT:
+		name = `~`
+	case parser.LSHIFT:
+		name = `<<`
+	case parser.RSHIFT:
+		name = `>>`
+	case parser.AND_ASSIGN:
+		name = `&=`
+	case parser.OR_ASSIGN:
+		name = `|=`
+	case parser.XOR_ASSIGN:
+		name = `^=`
+	case parser.LSHIFT_ASSIGN:
+		name = `<<=`
+	case parser.RSHIFT_ASSIGN:
+		name = `>>=`
 	case parser.EQ:
 		name = `==`
 	case parser.NOT_EQ:
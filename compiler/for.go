@@ -1,6 +1,8 @@
 package compiler
 
 import (
+	"math"
+
 	"github.com/shelmesky/bvm/parser"
 )
 
@@ -60,6 +62,33 @@ func newBinary(oper int, left, right *parser.Node) *parser.Node {
 	}
 }
 
+// setBound saves the count of iterations of the loop
+func (cmpl *compiler) setBound(loop *parser.Node, count int64) {
+	if count < 0 {
+		count = 0
+	}
+	if cmpl.Bounds == nil {
+		cmpl.Bounds = make(map[*parser.Node]int64)
+	}
+	cmpl.Bounds[loop] = count
+}
+
+// isAssigned returns true if the variable is changed by the statement. Every TSetVar node of
+// the subtree is the target of an assignment, x++, a multiple assignment and so on.
+func isAssigned(node *parser.Node, name string) bool {
+	var assigned bool
+	parser.Inspect(node, func(child *parser.Node) bool {
+		if child == nil || assigned {
+			return false
+		}
+		if child.Type == parser.TSetVar && child.Value.(*parser.NVarValue).Name == name {
+			assigned = true
+		}
+		return !assigned
+	})
+	return assigned
+}
+
 func forCode(node *parser.Node, cmpl *compiler) error {
 	var (
 		err      error
@@ -75,7 +104,7 @@ func forCode(node *parser.Node, cmpl *compiler) error {
 		nFor.KeyName = parser.RandName()
 		isKey = false
 	}
-	cmpl.Contract.Code = cmpl.Contract.Code[:curLen]
+	cmpl.truncate(curLen)
 	maintype, subtype := parseType(nFor.Expr.Result)
 	if maintype != parser.VArr && maintype != parser.VMap && maintype != parser.VBytes {
 		return cmpl.ErrorParam(nFor.Expr, errForType, Type2Str(nFor.Expr.Result))
@@ -90,9 +119,12 @@ func forCode(node *parser.Node, cmpl *compiler) error {
 	vars := []parser.NVar{
 		newNVar(nFor.Expr.Result, objName),
 		newNVar(subtype, nFor.VarName),
-		newNVar(uint32(keyType), nFor.KeyName),
-		newNVar(parser.VInt, iKey),
 	}
+	// the key of array is equal to the index so it is not used if it isn't defined
+	if isKey || maintype == parser.VMap || !cmpl.optimize() {
+		vars = append(vars, newNVar(uint32(keyType), nFor.KeyName))
+	}
+	vars = append(vars, newNVar(parser.VInt, iKey))
 	if maintype == parser.VMap {
 		vars = append(vars, newNVar((parser.VStr<<4)|parser.VArr, keysName))
 	}
@@ -166,6 +198,8 @@ func forCode(node *parser.Node, cmpl *compiler) error {
 			&parser.Node{
 				Line:   node.Line,
 				Column: node.Column,
+				Begin:  node.Begin,
+				Finish: node.Finish,
 				Type:   parser.TWhile,
 				Value: &parser.NWhile{
 					Cond: newBinary(parser.LT, newGetVar(iKey),
@@ -175,24 +209,32 @@ func forCode(node *parser.Node, cmpl *compiler) error {
 			},
 		}
 	} else {
-		code = []*parser.Node{initVars,
-			newBinary(parser.ASSIGN, newSetVar(objName), nFor.Expr),
-			&parser.Node{
-				Line:   node.Line,
-				Column: node.Column,
-				Type:   parser.TWhile,
-				Value: &parser.NWhile{
-					Cond: newBinary(parser.LT, newGetVar(iKey),
-						newCallFunc(`Len`, newGetVar(objName))),
-					Body: nFor.Body,
-				},
+		loop := &parser.Node{
+			Line:   node.Line,
+			Column: node.Column,
+			Begin:  node.Begin,
+			Finish: node.Finish,
+			Type:   parser.TWhile,
+			Value: &parser.NWhile{
+				Cond: newBinary(parser.LT, newGetVar(iKey),
+					newCallFunc(`Len`, newGetVar(objName))),
+				Body: nFor.Body,
 			},
 		}
+		code = []*parser.Node{initVars,
+			newBinary(parser.ASSIGN, newSetVar(objName), nFor.Expr), loop}
 	}
-	return nodeToCode(&parser.Node{
+	if err = nodeToCode(&parser.Node{
 		Type: parser.TBlock,
 		Value: &parser.NBlock{
-			Statements: code}}, cmpl)
+			Statements: code}}, cmpl); err != nil {
+		return err
+	}
+	if !isKey {
+		// the key has been defined by the compiler
+		cmpl.use(nFor.KeyName)
+	}
+	return nil
 }
 
 func forInt(node *parser.Node, cmpl *compiler) error {
@@ -213,11 +255,22 @@ func forInt(node *parser.Node, cmpl *compiler) error {
 	if nFor.To.Result != parser.VInt {
 		return cmpl.ErrorParam(nFor.To, errIndexInt, Type2Str(nFor.To.Result))
 	}
-	cmpl.Contract.Code = cmpl.Contract.Code[:curLen]
+	cmpl.truncate(curLen)
 	maxName := parser.RandName()
 	vars := []parser.NVar{
 		newNVar(parser.VInt, nFor.VarName),
-		newNVar(parser.VInt, maxName),
+	}
+	// VarName <= max  is compiled as two commands so VarName < max + 1 is used for constants
+	cond := newBinary(parser.LTE, newGetVar(nFor.VarName), newGetVar(maxName))
+	if v, ok := nFor.To.Value.(int64); ok && nFor.To.Type == parser.TValue && v < math.MaxInt64 &&
+		cmpl.optimize() {
+		cond = newBinary(parser.LT, newGetVar(nFor.VarName), &parser.Node{
+			Type:   parser.TValue,
+			Value:  v + 1,
+			Result: parser.VInt,
+		})
+	} else {
+		vars = append(vars, newNVar(parser.VInt, maxName))
 	}
 	if nFor.Body == nil {
 		nFor.Body = &parser.Node{
@@ -229,6 +282,8 @@ func forInt(node *parser.Node, cmpl *compiler) error {
 	}
 	var code []*parser.Node
 
+	// the loop has the constant count of iterations if the variable is changed only by the loop
+	bounded := !isAssigned(nFor.Body, nFor.VarName)
 	nFor.Body.Value.(*parser.NBlock).Statements = append(nFor.Body.Value.(*parser.NBlock).Statements,
 		[]*parser.Node{&parser.Node{
 			Type: parser.TEndLabel,
@@ -248,18 +303,27 @@ func forInt(node *parser.Node, cmpl *compiler) error {
 		},
 	}
 	code = []*parser.Node{initVars,
-		newBinary(parser.ASSIGN, newSetVar(nFor.VarName), nFor.From),
-		newBinary(parser.ASSIGN, newSetVar(maxName), nFor.To),
-		&parser.Node{
-			Line:   node.Line,
-			Column: node.Column,
-			Type:   parser.TWhile,
-			Value: &parser.NWhile{
-				Cond: newBinary(parser.LTE, newGetVar(nFor.VarName), newGetVar(maxName)),
-				Body: nFor.Body,
-			},
+		newBinary(parser.ASSIGN, newSetVar(nFor.VarName), nFor.From)}
+	if len(vars) > 1 {
+		code = append(code, newBinary(parser.ASSIGN, newSetVar(maxName), nFor.To))
+	}
+	loop := &parser.Node{
+		Line:   node.Line,
+		Column: node.Column,
+		Begin:  node.Begin,
+		Finish: node.Finish,
+		Type:   parser.TWhile,
+		Value: &parser.NWhile{
+			Cond: cond,
+			Body: nFor.Body,
 		},
 	}
+	if from, ok := constValue(nFor.From); ok && bounded {
+		if to, ok := constValue(nFor.To); ok {
+			cmpl.setBound(loop, to.(int64)-from.(int64)+1)
+		}
+	}
+	code = append(code, loop)
 	return nodeToCode(&parser.Node{
 		Type: parser.TBlock,
 		Value: &parser.NBlock{
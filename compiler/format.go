@@ -0,0 +1,40 @@
+package compiler
+
+import (
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// sprintf compiles Sprintf(format, values...). The values can have any type, their types are
+// the operands of SPRINTF. The constant format is checked at compile time.
+func (cmpl *compiler) sprintf(node *parser.Node) error {
+	nFunc := node.Value.(*parser.NCallFunc)
+	if nFunc.Params == nil {
+		return cmpl.Error(node, errSprintfFormat)
+	}
+	pars := nFunc.Params.Value.(*parser.NParams).Expr
+	vtypes := make([]int64, len(pars)-1)
+	for i, expr := range pars {
+		if err := nodeToCode(expr, cmpl); err != nil {
+			return err
+		}
+		if i == 0 {
+			if expr.Result != parser.VStr {
+				return cmpl.Error(node, errSprintfFormat)
+			}
+			continue
+		}
+		vtypes[i-1] = int64(expr.Result)
+	}
+	if format, ok := pars[0].Value.(string); ok && pars[0].Type == parser.TValue {
+		if err := rt.CheckFormat(format, vtypes); err != nil {
+			return cmpl.Error(node, err.Error())
+		}
+	}
+	cmpl.Append(rt.SPRINTF, rt.Bcode(len(vtypes)))
+	for _, vtype := range vtypes {
+		cmpl.Append(rt.Bcode(vtype))
+	}
+	node.Result = parser.VStr
+	return nil
+}
@@ -0,0 +1,308 @@
+package compiler
+
+import (
+	"fmt"
+
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+const (
+	unboundedLoop      = `loop without constant bounds`
+	unboundedFunc      = `function %s has unknown gas`
+	unboundedRecursion = `recursive call of function`
+	unboundedContract  = `recursive call of contract %s`
+	unboundedCode      = `unknown bytecode`
+	unboundedSlice     = `slice gas depends on its length`
+	unboundedSize      = `function %s gas depends on the size of the data`
+)
+
+// GasEstimate is the result of the static estimation of gas
+type GasEstimate struct {
+	Gas       int64 // the upper bound of gas if the code is bounded
+	Unbounded bool
+	Contract  string // the contract and the location of the code which cannot be bounded
+	Line      int
+	Column    uint32
+	Reason    string
+}
+
+func (est GasEstimate) String() string {
+	if !est.Unbounded {
+		return fmt.Sprint(est.Gas)
+	}
+	if est.Line > 0 {
+		return fmt.Sprintf("unbounded: %s %d:%d: %s", est.Contract, est.Line, est.Column, est.Reason)
+	}
+	return fmt.Sprintf("unbounded: %s: %s", est.Contract, est.Reason)
+}
+
+// unbounded is used to stop the estimation
+type unbounded struct {
+	GasEstimate
+}
+
+// loop is a loop in the bytecode. The instructions from Start to End are the body of the loop,
+// the jumps back to Start begin the next iteration.
+type loop struct {
+	Start int
+	End   int
+	Info  *rt.LoopInfo
+}
+
+type gasEstimator struct {
+	Contract  *rt.Contract
+	Contracts []*rt.Contract
+	Custom    *rt.Custom
+	Calls     map[*rt.Contract]bool // the contracts which are being estimated
+	List      []*instr
+	Index     map[*instr]int
+	Loops     map[int]*loop // loops by the index of the first instruction
+	Best      map[int]int64
+	State     map[int]int // 1 - is being calculated, 2 - done
+	Paths     map[[3]int]int64
+}
+
+const none = -1 << 62 // there is not any path
+
+func max64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
+
+// EstimateGas calculates the upper bound of gas which can be used by the contract.
+// Loops must have constant bounds, custom functions must have the annotation of gas.
+func EstimateGas(cnt *rt.Contract, contracts []*rt.Contract, custom *rt.Custom) (ret GasEstimate) {
+	defer func() {
+		if r := recover(); r != nil {
+			if stop, ok := r.(unbounded); ok {
+				ret = stop.GasEstimate
+				return
+			}
+			panic(r)
+		}
+	}()
+	ret.Gas = estimateContract(cnt, contracts, custom, make(map[*rt.Contract]bool))
+	return
+}
+
+func estimateContract(cnt *rt.Contract, contracts []*rt.Contract, custom *rt.Custom,
+	calls map[*rt.Contract]bool) int64 {
+	est := &gasEstimator{
+		Contract:  cnt,
+		Contracts: contracts,
+		Custom:    custom,
+		Calls:     calls,
+		Loops:     make(map[int]*loop),
+		Best:      make(map[int]int64),
+		State:     make(map[int]int),
+		Paths:     make(map[[3]int]int64),
+	}
+	if calls[cnt] {
+		est.stop(nil, fmt.Sprintf(unboundedContract, cnt.Name))
+	}
+	calls[cnt] = true
+	defer delete(calls, cnt)
+
+	code := cnt.Code
+	if len(code) > 1 && code[0] == rt.DATA {
+		code = code[2+int(code[1]):]
+	}
+	offsets := make(map[int]*instr)
+	var ok bool
+	if est.List, ok = decode(code, offsets); !ok {
+		est.stop(nil, unboundedCode)
+	}
+	est.Index = make(map[*instr]int)
+	for i, item := range est.List {
+		est.Index[item] = i
+	}
+	infos := make(map[int]*rt.LoopInfo)
+	for i := range cnt.Loops {
+		if item, ok := offsets[cnt.Loops[i].Offset]; ok {
+			infos[est.Index[item]] = &cnt.Loops[i]
+		}
+	}
+	for i, item := range est.List {
+		if item.Target == nil || len(item.Code) == 0 || (item.Code[0] != rt.JMP && item.Code[0] != rt.JMPREL) {
+			continue
+		}
+		start := est.Index[item.Target]
+		if start > i {
+			continue
+		}
+		if l, ok := est.Loops[start]; ok {
+			if i > l.End {
+				l.End = i
+			}
+			continue
+		}
+		est.Loops[start] = &loop{Start: start, End: i, Info: infos[start]}
+	}
+	return est.best(0)
+}
+
+func (est *gasEstimator) stop(info *rt.LoopInfo, reason string) {
+	stop := unbounded{GasEstimate{Unbounded: true, Contract: est.Contract.Name, Reason: reason}}
+	if info != nil {
+		stop.Line, stop.Column = info.Line, info.Column
+	}
+	panic(stop)
+}
+
+// cost returns the gas of the instruction
+func (est *gasEstimator) cost(i int) int64 {
+	item := est.List[i]
+	if len(item.Code) == 0 {
+		return 0
+	}
+	gas := int64(1)
+	switch item.Code[0] {
+	case rt.EMBEDFUNC:
+		eFunc := rt.StdLib[item.Code[1]]
+		if eFunc.Sized() {
+			est.stop(nil, fmt.Sprintf(unboundedSize, eFunc.Name))
+		}
+		gas += eFunc.Gas
+	case rt.CUSTOMFUNC:
+		fItem := est.Custom.Funcs[item.Code[1]]
+		if fItem.Gas <= 0 {
+			est.stop(nil, fmt.Sprintf(unboundedFunc, fItem.Name))
+		}
+		gas += fItem.Gas
+	case rt.CALLCONTRACT:
+		gas += estimateContract(est.Contracts[item.Code[1]], est.Contracts, est.Custom, est.Calls)
+	case rt.CALLFUNC:
+		gas += est.best(est.Index[item.Target])
+	case rt.SLICE:
+		est.stop(nil, unboundedSlice)
+	case rt.SPRINTF:
+		gas += int64(item.Code[1])
+	}
+	return gas
+}
+
+// next returns the indexes of the next instructions
+func (est *gasEstimator) next(i int) []int {
+	item := est.List[i]
+	if len(item.Code) == 0 {
+		return nil
+	}
+	switch item.Code[0] {
+	case rt.RETURN, rt.RETFUNC, rt.THROW:
+		return nil
+	case rt.JMP, rt.JMPREL:
+		return []int{est.Index[item.Target]}
+	case rt.JZE, rt.JNZ, rt.TRY:
+		return []int{i + 1, est.Index[item.Target]}
+	}
+	return []int{i + 1}
+}
+
+// best returns the maximum gas from the instruction to the end of the contract or the function
+func (est *gasEstimator) best(i int) int64 {
+	switch est.State[i] {
+	case 1:
+		est.stop(nil, unboundedRecursion)
+	case 2:
+		return est.Best[i]
+	}
+	est.State[i] = 1
+	gas := int64(none)
+	if l, ok := est.Loops[i]; ok {
+		targets, terminal := est.exits(l)
+		if terminal {
+			gas = 0
+		}
+		for _, target := range targets {
+			gas = max64(gas, est.best(target))
+		}
+		gas = max64(gas, 0) + est.loopGas(l)
+	} else {
+		for _, next := range est.next(i) {
+			gas = max64(gas, est.best(next))
+		}
+		gas = max64(gas, 0) + est.cost(i)
+	}
+	est.Best[i] = gas
+	est.State[i] = 2
+	return gas
+}
+
+// loopGas returns the maximum gas of the loop. It is the gas of all iterations and the path
+// which leaves the loop.
+func (est *gasEstimator) loopGas(l *loop) int64 {
+	if l.Info == nil || l.Info.Count < 0 {
+		est.stop(l.Info, unboundedLoop)
+	}
+	iter := max64(est.path(l, l.Start, true, true), 0)
+	return l.Info.Count*iter + max64(est.path(l, l.Start, true, false), 0)
+}
+
+// path returns the maximum gas of the path from the instruction inside the loop to the next iteration
+// if iter is true or out of the loop if iter is false. The loops inside are counted entirely.
+func (est *gasEstimator) path(l *loop, i int, first, iter bool) int64 {
+	if i < l.Start || i > l.End {
+		if iter {
+			return none
+		}
+		return 0
+	}
+	if i == l.Start && !first {
+		if iter {
+			return 0
+		}
+		return none
+	}
+	key := [3]int{l.Start, i, 0}
+	if iter {
+		key[2] = 1
+	}
+	if gas, ok := est.Paths[key]; ok {
+		return gas
+	}
+	gas := int64(none)
+	if inner, ok := est.Loops[i]; ok && !first {
+		targets, terminal := est.exits(inner)
+		if terminal && !iter {
+			gas = 0
+		}
+		for _, target := range targets {
+			gas = max64(gas, est.path(l, target, false, iter))
+		}
+		if gas != none {
+			gas += est.loopGas(inner)
+		}
+	} else {
+		nexts := est.next(i)
+		if len(nexts) == 0 && !iter {
+			gas = 0
+		}
+		for _, next := range nexts {
+			gas = max64(gas, est.path(l, next, false, iter))
+		}
+		if gas != none {
+			gas += est.cost(i)
+		}
+	}
+	est.Paths[key] = gas
+	return gas
+}
+
+// exits returns the instructions outside of the loop which follow the instructions of the loop.
+// terminal is true if the loop contains return.
+func (est *gasEstimator) exits(l *loop) (ret []int, terminal bool) {
+	for i := l.Start; i <= l.End; i++ {
+		nexts := est.next(i)
+		if len(nexts) == 0 {
+			terminal = true
+		}
+		for _, next := range nexts {
+			if next < l.Start || next > l.End {
+				ret = append(ret, next)
+			}
+		}
+	}
+	return
+}
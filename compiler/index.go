@@ -0,0 +1,124 @@
+package compiler
+
+import (
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// compound returns the binary operator of the compound assignment like + for +=
+func compound(oper int) int {
+	switch oper {
+	case parser.ADD_ASSIGN:
+		return parser.ADD
+	case parser.SUB_ASSIGN:
+		return parser.SUB
+	case parser.MUL_ASSIGN:
+		return parser.MUL
+	case parser.DIV_ASSIGN:
+		return parser.DIV
+	case parser.MOD_ASSIGN:
+		return parser.MOD
+	case parser.AND_ASSIGN:
+		return parser.BIT_AND
+	case parser.OR_ASSIGN:
+		return parser.BIT_OR
+	case parser.XOR_ASSIGN:
+		return parser.BIT_XOR
+	case parser.LSHIFT_ASSIGN:
+		return parser.LSHIFT
+	case parser.RSHIFT_ASSIGN:
+		return parser.RSHIFT
+	}
+	return 0
+}
+
+// assignIndex compiles the compound assignment of the item like m[key] += value. The container
+// and the index are evaluated only once, DUP2 keeps them for the assignment. The missing key
+// of the map gets the default value so counts[word] += 1 works for the new words.
+func (cmpl *compiler) assignIndex(node *parser.Node) error {
+	nBinary := node.Value.(*parser.NBinary)
+	nBinary.Left.Type = parser.TSetIndex
+	if err := nodeToCode(nBinary.Left, cmpl); err != nil {
+		return err
+	}
+	outtype, subtype := parseType(nBinary.Left.Result)
+	cmpl.Append(rt.DUP2)
+	switch outtype {
+	case parser.VMap:
+		cmpl.Append(rt.GETMAPDEF, rt.Bcode(subtype))
+	default:
+		cmpl.Append(rt.GETINDEX)
+	}
+	if err := nodeToCode(nBinary.Right, cmpl); err != nil {
+		return err
+	}
+	// the item is compiled as the left operand of the binary operator
+	oper := &parser.Node{
+		Type:   parser.TBinary,
+		Line:   node.Line,
+		Column: node.Column,
+		Value: &parser.NBinary{
+			Oper:  compound(nBinary.Oper),
+			Left:  &parser.Node{Result: subtype},
+			Right: nBinary.Right,
+		},
+	}
+	code, result := cmpl.findBinary(oper.Value.(*parser.NBinary))
+	if code == rt.NOP || result != subtype {
+		oper.Value.(*parser.NBinary).Oper = nBinary.Oper
+		return cmpl.ErrorOperator(oper)
+	}
+	cmpl.position(node, code)
+	cmpl.Append(code)
+	switch outtype {
+	case parser.VMap:
+		cmpl.Append(rt.ASSIGNSETMAP)
+	case parser.VBytes:
+		cmpl.Append(rt.ASSIGNSETBYTES)
+	default:
+		cmpl.Append(rt.ASSIGNSETARR)
+	}
+	node.Result = parser.VVoid
+	return nil
+}
+
+// slice compiles the part of arr, bytes or str like a[1:3]. The missing beginning is 0,
+// the missing end is specified by the operand of SLICE.
+func (cmpl *compiler) slice(node *parser.Node) error {
+	nSlice := node.Value.(*parser.NSlice)
+	if err := nodeToCode(nSlice.Expr, cmpl); err != nil {
+		return err
+	}
+	vtype := nSlice.Expr.Result
+	switch vtype & 0xf {
+	case parser.VArr, parser.VBytes, parser.VStr:
+	default:
+		return cmpl.ErrorParam(node, errSliceType, Type2Str(vtype))
+	}
+	if nSlice.From == nil {
+		cmpl.Append(rt.PUSH16, 0)
+	} else if err := cmpl.sliceBound(nSlice.From); err != nil {
+		return err
+	}
+	var hasEnd rt.Bcode
+	if nSlice.To != nil {
+		if err := cmpl.sliceBound(nSlice.To); err != nil {
+			return err
+		}
+		hasEnd = 1
+	}
+	cmpl.Append(rt.SLICE, rt.Bcode(vtype), hasEnd)
+	node.Result = vtype
+	return nil
+}
+
+// sliceBound compiles the bound of the slice which must be int
+func (cmpl *compiler) sliceBound(bound *parser.Node) error {
+	if err := nodeToCode(bound, cmpl); err != nil {
+		return err
+	}
+	if bound.Result != parser.VInt {
+		return cmpl.ErrorParam(bound, errIndexInt, Type2Str(bound.Result))
+	}
+	return nil
+}
@@ -0,0 +1,146 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// libraryName returns the name of the library if the function name is qualified like Utils.fn
+func libraryName(name string) string {
+	if off := strings.IndexByte(name, '.'); off > 0 {
+		return name[:off]
+	}
+	return ``
+}
+
+// checkLibrary checks that the library contains only the functions and the imports
+func (cmpl *compiler) checkLibrary(node *parser.Node) error {
+	nBlock := node.Value.(*parser.NContract).Block.Value.(*parser.NBlock)
+	if len(nBlock.Params) > 0 {
+		return cmpl.Error(node, errLibraryData)
+	}
+	for _, stmt := range nBlock.Statements {
+		if stmt.Type != parser.TFunc && stmt.Type != parser.TImport {
+			return cmpl.Error(stmt, errLibraryStmt)
+		}
+	}
+	return nil
+}
+
+// imported returns true if the library has been imported by the contract
+func (cmpl *compiler) imported(name string) bool {
+	for _, item := range cmpl.Contract.Calls {
+		if item == name {
+			return true
+		}
+	}
+	return false
+}
+
+// qualify renames the functions and the variables of the library. The functions get the names
+// like Utils.fn, the variables are renamed in the same way so they cannot conflict with the
+// variables of the contract because the identifiers don't contain dots.
+func qualify(root *parser.Node) {
+	nContract := root.Value.(*parser.NContract)
+	prefix := nContract.Name + `.`
+	funcs := make(map[string]bool)
+	for _, stmt := range nContract.Block.Value.(*parser.NBlock).Statements {
+		if stmt.Type == parser.TFunc {
+			funcs[stmt.Value.(*parser.NFunc).Name] = true
+		}
+	}
+	rename := func(vars []parser.NVar) {
+		for i := range vars {
+			vars[i].Name = prefix + vars[i].Name
+		}
+	}
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if node == nil {
+			return false
+		}
+		switch v := node.Value.(type) {
+		case *parser.NFunc:
+			v.Name = prefix + v.Name
+			rename(v.Params)
+		case *parser.NCallFunc:
+			if funcs[v.Name] {
+				v.Name = prefix + v.Name
+			}
+		case *parser.NVars:
+			rename(v.Vars)
+		case *parser.NVarValue:
+			v.Name = prefix + v.Name
+		case *parser.NGetIndex:
+			v.Name = prefix + v.Name
+		case *parser.NFor:
+			v.VarName = prefix + v.VarName
+			if len(v.KeyName) > 0 {
+				v.KeyName = prefix + v.KeyName
+			}
+		case *parser.NForInt:
+			v.VarName = prefix + v.VarName
+		case *parser.NTry:
+			v.Var = prefix + v.Var
+		}
+		return true
+	})
+}
+
+// importLibrary compiles the functions of the library into the contract. The library is
+// parsed again from its source, so the calls of the imported functions are type-checked and
+// executed in the frame of the calling contract as its own functions.
+func (cmpl *compiler) importLibrary(node *parser.Node) error {
+	name := node.Value.(*parser.NImport).Name
+	if len(cmpl.Blocks) != 1 || cmpl.InFunc {
+		return cmpl.Error(node, errImportLevel)
+	}
+	ind, ok := (*cmpl.NameSpace)[name]
+	if !ok || (*cmpl.Contracts)[ind] == nil {
+		return cmpl.ErrorParam(node, errLibraryNotExists, name)
+	}
+	lib := (*cmpl.Contracts)[ind]
+	if !lib.Library {
+		return cmpl.ErrorParam(node, errNotLibrary, name)
+	}
+	if cmpl.imported(name) {
+		return nil
+	}
+	return cmpl.compileLibrary(node, lib)
+}
+
+func (cmpl *compiler) compileLibrary(node *parser.Node, lib *rt.Contract) error {
+	// the source of the library can be the file with several contracts
+	roots, err := parser.ParseFile(``, lib.Source)
+	var root *parser.Node
+	for _, item := range roots {
+		if item.Value.(*parser.NContract).Name == lib.Name {
+			root = item
+		}
+	}
+	if err != nil || root == nil {
+		return cmpl.ErrorParam(node, errLibraryNotExists, lib.Name)
+	}
+	cmpl.addCall(lib.Name)
+	qualify(root)
+	// the warnings of the analyzer are related to the library, not to the contract
+	analysis := cmpl.Analysis
+	cmpl.Analysis = nil
+	defer func() {
+		cmpl.Analysis = analysis
+	}()
+	for _, stmt := range root.Value.(*parser.NContract).Block.Value.(*parser.NBlock).Statements {
+		if stmt.Type == parser.TImport {
+			// the nested libraries are imported before the functions which call them
+			if err = cmpl.importLibrary(stmt); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = nodeToCode(stmt, cmpl); err != nil {
+			return err
+		}
+	}
+	return nil
+}
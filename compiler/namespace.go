@@ -67,9 +67,68 @@ var (
 		{rt.ASSIGNINT, parser.VObject, parser.ASSIGN, parser.VObject, parser.VObject},      // obj = obj
 		{rt.ASSIGNINT, parser.VVoid, parser.ASSIGN, parser.VBytes, parser.VBytes},          // bytes = bytes
 		{rt.ASSIGNADDBYTES, parser.VVoid, parser.ADD_ASSIGN, parser.VBytes, parser.VBytes}, // bytes += bytes
+		{rt.MODMONEY, parser.VMoney, parser.MOD, parser.VMoney, parser.VMoney},             // money%money
+		{rt.ASSIGNMODMONEY, parser.VVoid, parser.MOD_ASSIGN, parser.VMoney, parser.VMoney}, // money %= money
+		{rt.LTSTR, parser.VBool, parser.LT, parser.VStr, parser.VStr},                      // str < str
+		{rt.GTSTR, parser.VBool, parser.GT, parser.VStr, parser.VStr},                      // str > str
+		{rt.EQINT, parser.VBool, parser.EQ, parser.VBool, parser.VBool},                    // bool == bool
+		{rt.EQBYTES, parser.VBool, parser.EQ, parser.VBytes, parser.VBytes},                // bytes == bytes
+		{rt.LTBYTES, parser.VBool, parser.LT, parser.VBytes, parser.VBytes},                // bytes < bytes
+		{rt.GTBYTES, parser.VBool, parser.GT, parser.VBytes, parser.VBytes},                // bytes > bytes
+		{rt.NOTINT, parser.VInt, parser.BIT_NOT, parser.VInt},                              // ~int
+		{rt.ANDINT, parser.VInt, parser.BIT_AND, parser.VInt, parser.VInt},                 // int & int
+		{rt.ORINT, parser.VInt, parser.BIT_OR, parser.VInt, parser.VInt},                   // int | int
+		{rt.XORINT, parser.VInt, parser.BIT_XOR, parser.VInt, parser.VInt},                 // int ^ int
+		{rt.SHLINT, parser.VInt, parser.LSHIFT, parser.VInt, parser.VInt},                  // int << int
+		{rt.SHRINT, parser.VInt, parser.RSHIFT, parser.VInt, parser.VInt},                  // int >> int
+		{rt.ASSIGNANDINT, parser.VVoid, parser.AND_ASSIGN, parser.VInt, parser.VInt},       // int &= int
+		{rt.ASSIGNORINT, parser.VVoid, parser.OR_ASSIGN, parser.VInt, parser.VInt},         // int |= int
+		{rt.ASSIGNXORINT, parser.VVoid, parser.XOR_ASSIGN, parser.VInt, parser.VInt},       // int ^= int
+		{rt.ASSIGNSHLINT, parser.VVoid, parser.LSHIFT_ASSIGN, parser.VInt, parser.VInt},    // int <<= int
+		{rt.ASSIGNSHRINT, parser.VVoid, parser.RSHIFT_ASSIGN, parser.VInt, parser.VInt},    // int >>= int
+		{rt.NOTBYTES, parser.VBytes, parser.BIT_NOT, parser.VBytes},                        // ~bytes
+		{rt.ANDBYTES, parser.VBytes, parser.BIT_AND, parser.VBytes, parser.VBytes},         // bytes & bytes
+		{rt.ORBYTES, parser.VBytes, parser.BIT_OR, parser.VBytes, parser.VBytes},           // bytes | bytes
+		{rt.XORBYTES, parser.VBytes, parser.BIT_XOR, parser.VBytes, parser.VBytes},         // bytes ^ bytes
+		{rt.ASSIGNANDBYTES, parser.VVoid, parser.AND_ASSIGN, parser.VBytes, parser.VBytes}, // bytes &= bytes
+		{rt.ASSIGNORBYTES, parser.VVoid, parser.OR_ASSIGN, parser.VBytes, parser.VBytes},   // bytes |= bytes
+		{rt.ASSIGNXORBYTES, parser.VVoid, parser.XOR_ASSIGN, parser.VBytes, parser.VBytes}, // bytes ^= bytes
 	}
 )
 
+// promotion returns the type to which the operands of the different types are converted.
+// int is promoted to float or money. float and money are not mixed because the conversion
+// between them can lose the precision, it must be done explicitly.
+func promotion(left, right uint32) uint32 {
+	switch {
+	case left == parser.VInt && (right == parser.VFloat || right == parser.VMoney):
+		return right
+	case right == parser.VInt && (left == parser.VFloat || left == parser.VMoney):
+		return left
+	}
+	return parser.VVoid
+}
+
+// isAssign returns true if the operator changes the left operand
+func isAssign(oper int) bool {
+	switch oper {
+	case parser.ASSIGN, parser.ADD_ASSIGN, parser.SUB_ASSIGN, parser.MUL_ASSIGN,
+		parser.DIV_ASSIGN, parser.MOD_ASSIGN, parser.AND_ASSIGN, parser.OR_ASSIGN, parser.XOR_ASSIGN,
+		parser.LSHIFT_ASSIGN, parser.RSHIFT_ASSIGN:
+		return true
+	}
+	return false
+}
+
+// isDeepEqual returns true if the values of the type are compared item by item
+func isDeepEqual(vtype uint32) bool {
+	switch vtype & 0xf {
+	case parser.VArr, parser.VMap, parser.VObject:
+		return true
+	}
+	return false
+}
+
 func parseType(intype uint32) (outtype, subtype uint32) {
 	if intype == parser.VBytes {
 		subtype = parser.VInt
@@ -87,12 +146,40 @@ func (cmpl *compiler) findBinary(binary *parser.NBinary) (rt.Bcode, uint32) {
 	if v, ok := (*cmpl.NameSpace)[key]; ok {
 		return rt.Bcode(v & 0xffff), v >> 24
 	}
+	if target := promotion(binary.Left.Result, binary.Right.Result); target != parser.VVoid {
+		key = fmt.Sprintf("#%d#%d#%d", binary.Oper, target, target)
+		if v, ok := (*cmpl.NameSpace)[key]; ok {
+			convert := rt.Bcode(rt.INTFLOAT)
+			if target == parser.VMoney {
+				convert = rt.INTMONEY
+			}
+			if binary.Right.Result == parser.VInt {
+				cmpl.Append(convert, 0)
+			} else if isAssign(binary.Oper) {
+				// the variable of int type cannot get float or money
+				return rt.NOP, 0
+			} else {
+				cmpl.Append(convert, 1)
+			}
+			return rt.Bcode(v & 0xffff), v >> 24
+		}
+	}
+	if binary.Oper == parser.EQ && binary.Left.Result == binary.Right.Result &&
+		isDeepEqual(binary.Left.Result) {
+		return rt.EQDEEP, parser.VBool
+	}
 	if binary.Oper == parser.ADD_ASSIGN {
 		outtype, subtype := parseType(binary.Left.Result)
 		if outtype&0xf == parser.VArr && subtype == binary.Right.Result {
 			return rt.APPENDARR, parser.VVoid
 		}
 	}
+	if binary.Oper == parser.ASSIGN && binary.Left.Result&0xf == parser.VStruct {
+		if binary.Left.Result == binary.Right.Result {
+			return rt.ASSIGNINT, parser.VVoid
+		}
+		return rt.NOP, 0
+	}
 	if binary.Oper == parser.ASSIGN && (binary.Left.Result&0xf == parser.VArr ||
 		binary.Left.Result&0xf == parser.VMap || binary.Left.Result&0xf == parser.VBytes) {
 		if binary.Left.Result == binary.Right.Result {
@@ -235,6 +322,9 @@ main:
 			ret += `obj`
 		case parser.VBytes:
 			ret += `bytes`
+		case parser.VStruct:
+			ret += `struct`
+			break main
 		default:
 			break main
 		}
@@ -0,0 +1,554 @@
+package compiler
+
+import (
+	"math"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+/*
+优化分为两个阶段:
+1. 编译之前在语法树上进行常量折叠。
+2. 编译之后在字节码上进行跳转线程化(跳转到跳转指令时直接跳到最终位置)，常量条件的跳转变为无条件跳转，
+   删除不可达的指令(return/break/continue之后的代码，常量条件下不会执行的分支，未调用的函数)，
+   并将常见的指令序列合并为超级指令，例如 i += 1 合并为 INCVAR。
+所有代码在优化之前都会被编译，所以不可达代码中的错误依然会被报告。
+for循环中不需要的临时变量在for.go中生成代码时就被省略。
+*/
+
+// instr is a decoded bytecode instruction
+type instr struct {
+	Code   []rt.Bcode
+	Target *instr // the target of the jump instruction
+	Label  bool   // the instruction is a target of some jump
+	Reach  bool
+	Into   *instr // the instruction which this one has been fused into
+}
+
+// operands returns the count of operands of the instruction at i
+func operands(code []rt.Bcode, i int) int {
+	switch code[i] {
+	case rt.PUSH16, rt.DELVARS, rt.GETVAR, rt.SETVAR, rt.JMP, rt.JMPREL, rt.JZE, rt.JNZ,
+		rt.CALLFUNC, rt.EMBEDFUNC, rt.CUSTOMFUNC, rt.CALLCONTRACT, rt.RETURN, rt.COPY,
+		rt.INITARR, rt.INITMAP, rt.INITOBJ, rt.INITOBJLIST, rt.ENV, rt.ISSET, rt.NEWSTRUCT,
+		rt.GETFIELD, rt.SETFIELD, rt.INITFIELD, rt.INTFLOAT, rt.INTMONEY, rt.EQDEEP, rt.GETMAPDEF:
+		return 1
+	case rt.PUSH32, rt.PUSHSTR, rt.PARCONTRACT, rt.INCVAR, rt.COPYVAR, rt.TRY, rt.SLICE:
+		return 2
+	case rt.PUSH64:
+		return 4
+	case rt.INITVARS, rt.GETPARAMS, rt.DATA, rt.SPRINTF:
+		return 1 + int(code[i+1])
+	}
+	return 0
+}
+
+// isJump returns true if the command has a relative offset as the operand
+func isJump(cmd rt.Bcode) bool {
+	switch cmd {
+	case rt.JMP, rt.JMPREL, rt.JZE, rt.JNZ, rt.CALLFUNC, rt.TRY:
+		return true
+	}
+	return false
+}
+
+// decode splits the bytecode into the list of instructions. The last item of the list
+// is an empty instruction which marks the end of the code.
+func decode(code []rt.Bcode, offsets map[int]*instr) ([]*instr, bool) {
+	list := make([]*instr, 0, len(code)/2+1)
+	for i := 0; i < len(code); {
+		size := operands(code, i) + 1
+		if i+size > len(code) {
+			return nil, false
+		}
+		item := &instr{Code: append([]rt.Bcode{}, code[i:i+size]...)}
+		offsets[i] = item
+		list = append(list, item)
+		i += size
+	}
+	end := &instr{}
+	offsets[len(code)] = end
+	list = append(list, end)
+
+	var off int
+	for _, item := range list {
+		if len(item.Code) > 0 && isJump(item.Code[0]) {
+			if item.Target = offsets[off+int(int16(item.Code[1]))]; item.Target == nil {
+				return nil, false
+			}
+		}
+		off += len(item.Code)
+	}
+	return list, true
+}
+
+// encode joins the instructions and recalculates the offsets of jumps
+func encode(list []*instr, offsets map[*instr]int) ([]rt.Bcode, bool) {
+	var size int
+	for _, item := range list {
+		offsets[item] = size
+		size += len(item.Code)
+	}
+	code := make([]rt.Bcode, 0, size)
+	for _, item := range list {
+		if item.Target != nil {
+			off := offsets[item.Target] - offsets[item]
+			if off < math.MinInt16 || off > math.MaxInt16 {
+				return nil, false
+			}
+			item.Code[1] = rt.Bcode(off)
+		}
+		code = append(code, item.Code...)
+	}
+	return code, true
+}
+
+// threadJumps redirects the jumps which lead to other jumps
+func threadJumps(list []*instr) {
+	for _, item := range list {
+		if item.Target == nil {
+			continue
+		}
+		cmd := item.Code[0]
+		if cmd != rt.JMP && cmd != rt.JMPREL {
+			continue
+		}
+		// JMP clears the stack so JMPREL can be threaded only through JMPREL
+		for count := 0; count < len(list); count++ {
+			next := item.Target
+			if len(next.Code) == 0 || next == item || (next.Code[0] != rt.JMPREL &&
+				(next.Code[0] != rt.JMP || cmd != rt.JMP)) {
+				break
+			}
+			item.Target = next.Target
+		}
+	}
+}
+
+// dropUnreachable removes the instructions which can't be executed
+func dropUnreachable(list []*instr) []*instr {
+	index := make(map[*instr]int)
+	for i, item := range list {
+		index[item] = i
+		item.Reach = false
+	}
+	queue := []int{0}
+	for len(queue) > 0 {
+		i := queue[len(queue)-1]
+		queue = queue[:len(queue)-1]
+		for ; i < len(list) && !list[i].Reach; i++ {
+			item := list[i]
+			item.Reach = true
+			if item.Target != nil {
+				queue = append(queue, index[item.Target])
+			}
+			if len(item.Code) > 0 {
+				cmd := item.Code[0]
+				if cmd == rt.JMP || cmd == rt.JMPREL || cmd == rt.RETURN || cmd == rt.RETFUNC {
+					break
+				}
+			}
+		}
+	}
+	out := list[:0]
+	for _, item := range list {
+		if item.Reach || len(item.Code) == 0 {
+			out = append(out, item)
+		}
+	}
+	return out
+}
+
+func markLabels(list []*instr) {
+	for _, item := range list {
+		item.Label = false
+	}
+	for _, item := range list {
+		if item.Target != nil {
+			item.Target.Label = true
+		}
+	}
+}
+
+// foldBranches replaces the conditional jumps with the constant conditions
+func foldBranches(list []*instr) []*instr {
+	markLabels(list)
+	out := list[:0]
+	for i := 0; i < len(list); i++ {
+		item := list[i]
+		if i+1 < len(list) && len(item.Code) > 0 && item.Code[0] == rt.PUSH16 && !list[i+1].Label &&
+			len(list[i+1].Code) > 0 && (list[i+1].Code[0] == rt.JZE || list[i+1].Code[0] == rt.JNZ) {
+			jump := list[i+1]
+			i++
+			if (item.Code[1] == 0) != (jump.Code[0] == rt.JZE) {
+				// the condition is never true so both commands are dropped
+				if !item.Label {
+					continue
+				}
+				item.Code, item.Target = []rt.Bcode{rt.JMPREL, 0}, list[i+1]
+			} else {
+				item.Code, item.Target = []rt.Bcode{rt.JMPREL, 0}, jump.Target
+			}
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// fuse replaces the frequent sequences of instructions with superinstructions
+func fuse(list []*instr) []*instr {
+	markLabels(list)
+	out := list[:0]
+	for i := 0; i < len(list); i++ {
+		item := list[i]
+		if i+2 < len(list) && len(item.Code) > 0 && item.Code[0] == rt.SETVAR &&
+			!list[i+1].Label && !list[i+2].Label && len(list[i+2].Code) > 0 {
+			next, last := list[i+1].Code, list[i+2].Code[0]
+			switch {
+			case next[0] == rt.PUSH16 && (last == rt.ASSIGNADDINT || last == rt.ASSIGNSUBINT):
+				// var += int16  var -= int16
+				delta := int16(next[1])
+				if last == rt.ASSIGNSUBINT {
+					delta = -delta
+				}
+				item.Code = []rt.Bcode{rt.INCVAR, item.Code[1], rt.Bcode(delta)}
+				list[i+2].Into = item
+				i += 2
+			case next[0] == rt.GETVAR && last == rt.ASSIGNINT:
+				// var = var
+				item.Code = []rt.Bcode{rt.COPYVAR, item.Code[1], next[1]}
+				i += 2
+			}
+		}
+		out = append(out, item)
+	}
+	return out
+}
+
+// optimizeCode runs the optimization passes on the bytecode of the contract
+func optimizeCode(cnt *rt.Contract) {
+	before := make(map[int]*instr)
+	list, ok := decode(cnt.Code, before)
+	if !ok {
+		return
+	}
+	list = foldBranches(list)
+	threadJumps(list)
+	list = fuse(dropUnreachable(list))
+	after := make(map[*instr]int)
+	code, ok := encode(list, after)
+	if !ok {
+		return
+	}
+	cnt.Code = code
+	for _, finfo := range cnt.Funcs {
+		if off, ok := after[before[finfo.Offset]]; ok {
+			finfo.Offset = off
+		} else {
+			finfo.Offset = -1 // the function is never called and its code has been removed
+		}
+	}
+	loops := cnt.Loops[:0]
+	for _, loop := range cnt.Loops {
+		if off, ok := after[before[loop.Offset]]; ok {
+			loop.Offset = off
+			loops = append(loops, loop)
+		}
+	}
+	cnt.Loops = loops
+	positions := cnt.Positions[:0]
+	for _, pos := range cnt.Positions {
+		item := before[pos.Offset]
+		if item != nil && item.Into != nil {
+			item = item.Into
+		}
+		if off, ok := after[item]; ok {
+			pos.Offset = off
+			positions = append(positions, pos)
+		}
+	}
+	cnt.Positions = positions
+}
+
+func valueType(value interface{}) uint32 {
+	switch value.(type) {
+	case int64:
+		return parser.VInt
+	case float64:
+		return parser.VFloat
+	case string:
+		return parser.VStr
+	case bool:
+		return parser.VBool
+	}
+	return parser.VVoid
+}
+
+// foldBinary calculates the binary operation with constant operands
+func foldBinary(oper int, left, right interface{}) (interface{}, bool) {
+	switch l := left.(type) {
+	case int64:
+		r, ok := right.(int64)
+		if !ok {
+			break
+		}
+		// the overflowing operation isn't folded so the checked mode raises the error
+		switch oper {
+		case parser.ADD:
+			return foldInt(rt.ADDINT, l, r)
+		case parser.SUB:
+			return foldInt(rt.SUBINT, l, r)
+		case parser.MUL:
+			return foldInt(rt.MULINT, l, r)
+		case parser.DIV:
+			if r != 0 {
+				return foldInt(rt.DIVINT, l, r)
+			}
+		case parser.MOD:
+			if r != 0 {
+				return l % r, true
+			}
+		case parser.BIT_AND:
+			return l & r, true
+		case parser.BIT_OR:
+			return l | r, true
+		case parser.BIT_XOR:
+			return l ^ r, true
+		case parser.LSHIFT:
+			if r >= 0 {
+				return foldInt(rt.SHLINT, l, r)
+			}
+		case parser.RSHIFT:
+			if r >= 0 && r < 64 {
+				return l >> uint64(r), true
+			}
+		case parser.EQ:
+			return l == r, true
+		case parser.NOT_EQ:
+			return l != r, true
+		case parser.LT:
+			return l < r, true
+		case parser.LTE:
+			return l <= r, true
+		case parser.GT:
+			return l > r, true
+		case parser.GTE:
+			return l >= r, true
+		}
+	case float64:
+		r, ok := right.(float64)
+		if !ok {
+			break
+		}
+		switch oper {
+		case parser.ADD:
+			return l + r, true
+		case parser.SUB:
+			return l - r, true
+		case parser.MUL:
+			return l * r, true
+		case parser.DIV:
+			if r != 0 {
+				return l / r, true
+			}
+		case parser.EQ:
+			return l == r, true
+		case parser.NOT_EQ:
+			return l != r, true
+		case parser.LT:
+			return l < r, true
+		case parser.LTE:
+			return l <= r, true
+		case parser.GT:
+			return l > r, true
+		case parser.GTE:
+			return l >= r, true
+		}
+	case string:
+		r, ok := right.(string)
+		if !ok {
+			break
+		}
+		switch oper {
+		case parser.ADD:
+			return l + r, true
+		case parser.EQ:
+			return l == r, true
+		case parser.NOT_EQ:
+			return l != r, true
+		}
+	case bool:
+		r, ok := right.(bool)
+		if !ok {
+			break
+		}
+		switch oper {
+		case parser.AND:
+			return l && r, true
+		case parser.OR:
+			return l || r, true
+		}
+	}
+	return nil, false
+}
+
+// foldUnary calculates the unary operation with the constant operand
+func foldUnary(oper int, operand interface{}) (interface{}, bool) {
+	switch v := operand.(type) {
+	case int64:
+		switch oper {
+		case parser.SUB:
+			return foldInt(rt.SIGNINT, v, 0)
+		case parser.BIT_NOT:
+			return ^v, true
+		}
+	case float64:
+		if oper == parser.SUB {
+			return -v, true
+		}
+	case bool:
+		if oper == parser.NOT {
+			return !v, true
+		}
+	}
+	return nil, false
+}
+
+// foldInt returns the result of the integer operation if it doesn't overflow
+func foldInt(cmd rt.Bcode, a, b int64) (interface{}, bool) {
+	if v, ok := rt.FoldInt(cmd, a, b); ok {
+		return v, true
+	}
+	return nil, false
+}
+
+func setValue(node *parser.Node, value interface{}) {
+	node.Type = parser.TValue
+	node.Value = value
+	node.Result = valueType(value)
+}
+
+// optimizeTree folds the constant expressions in the tree
+func optimizeTree(node *parser.Node) {
+	if node == nil {
+		return
+	}
+	switch node.Type {
+	case parser.TContract:
+		optimizeTree(node.Value.(*parser.NContract).Block)
+	case parser.TBlock:
+		nBlock := node.Value.(*parser.NBlock)
+		for _, par := range nBlock.Params {
+			optimizeTree(par.Exp)
+		}
+		for _, child := range nBlock.Statements {
+			optimizeTree(child)
+		}
+	case parser.TVars:
+		for _, v := range node.Value.(*parser.NVars).Vars {
+			optimizeTree(v.Exp)
+		}
+	case parser.TBinary:
+		nBinary := node.Value.(*parser.NBinary)
+		optimizeTree(nBinary.Left)
+		optimizeTree(nBinary.Right)
+		if nBinary.Left.Type == parser.TValue && nBinary.Right.Type == parser.TValue {
+			if v, ok := foldBinary(nBinary.Oper, nBinary.Left.Value, nBinary.Right.Value); ok {
+				setValue(node, v)
+			}
+		}
+	case parser.TUnary:
+		nUnary := node.Value.(*parser.NUnary)
+		optimizeTree(nUnary.Operand)
+		if nUnary.Operand.Type == parser.TValue {
+			if v, ok := foldUnary(nUnary.Oper, nUnary.Operand.Value); ok {
+				setValue(node, v)
+			}
+		}
+	case parser.TIf:
+		nIf := node.Value.(*parser.NIf)
+		optimizeTree(nIf.Cond)
+		optimizeTree(nIf.IfBody)
+		if nIf.ElifBody != nil {
+			for _, child := range nIf.ElifBody.Value.(*parser.NElif).List {
+				optimizeTree(child.Cond)
+				optimizeTree(child.Body)
+			}
+		}
+		optimizeTree(nIf.ElseBody)
+	case parser.TReturn:
+		nReturn := node.Value.(*parser.NReturn)
+		optimizeTree(nReturn.Expr)
+		for _, expr := range nReturn.List {
+			optimizeTree(expr)
+		}
+	case parser.TMultiAssign:
+		optimizeTree(node.Value.(*parser.NMultiAssign).Expr)
+	case parser.TWhile:
+		nWhile := node.Value.(*parser.NWhile)
+		optimizeTree(nWhile.Cond)
+		optimizeTree(nWhile.Body)
+	case parser.TQuestion:
+		nQuestion := node.Value.(*parser.NQuestion)
+		optimizeTree(nQuestion.Cond)
+		optimizeTree(nQuestion.Left)
+		optimizeTree(nQuestion.Right)
+	case parser.TFunc:
+		optimizeTree(node.Value.(*parser.NFunc).Body)
+	case parser.TCallFunc:
+		if params := node.Value.(*parser.NCallFunc).Params; params != nil {
+			for _, expr := range params.Value.(*parser.NParams).Expr {
+				optimizeTree(expr)
+			}
+		}
+	case parser.TCallContract:
+		for _, par := range node.Value.(*parser.NCallContract).Params {
+			optimizeTree(par.Expr)
+		}
+	case parser.TGetIndex, parser.TSetIndex:
+		for _, item := range node.Value.(*parser.NGetIndex).Indexes {
+			optimizeTree(item)
+		}
+	case parser.TSlice:
+		nSlice := node.Value.(*parser.NSlice)
+		optimizeTree(nSlice.Expr)
+		optimizeTree(nSlice.From)
+		optimizeTree(nSlice.To)
+	case parser.TFor:
+		nFor := node.Value.(*parser.NFor)
+		optimizeTree(nFor.Expr)
+		optimizeTree(nFor.Body)
+	case parser.TForInt:
+		nFor := node.Value.(*parser.NForInt)
+		optimizeTree(nFor.From)
+		optimizeTree(nFor.To)
+		optimizeTree(nFor.Body)
+	case parser.TArray:
+		for _, item := range node.Value.(*parser.NArray).List {
+			optimizeTree(item)
+		}
+	case parser.TMap:
+		for _, item := range node.Value.(*parser.NMap).List {
+			optimizeTree(item.Value)
+		}
+	case parser.TObject:
+		for _, item := range node.Value.(*parser.NObject).List {
+			optimizeTree(item.Value)
+		}
+	case parser.TObjArr:
+		for _, item := range node.Value.(*parser.NObjArr).List {
+			optimizeTree(item)
+		}
+	case parser.TObjList:
+		optimizeTree(node.Value.(*parser.NObjList).Obj)
+	case parser.TSwitch:
+		nSwitch := node.Value.(*parser.NSwitch)
+		optimizeTree(nSwitch.Expr)
+		for _, icase := range nSwitch.Case.Value.(*parser.NCase).List {
+			optimizeTree(icase.ExprList)
+			optimizeTree(icase.Body)
+		}
+		optimizeTree(nSwitch.Default)
+	}
+}
@@ -0,0 +1,64 @@
+package compiler
+
+import (
+	"sort"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// setAttr parses the attributes of the data parameter
+func (cmpl *compiler) setAttr(par parser.NVar) error {
+	vtype := par.Type.Value.(*parser.NType).Type
+	attr, err := rt.ParseAttr(par.Attr, uint32(vtype))
+	if err != nil {
+		return cmpl.Error(par.Type, err.Error())
+	}
+	if cmpl.Contract.Attrs == nil {
+		cmpl.Contract.Attrs = make(map[string]*rt.ParamAttr)
+	}
+	cmpl.Contract.Attrs[par.Name] = attr
+	return nil
+}
+
+// isSet compiles IsSet("name") which returns true if the data parameter has been passed.
+// The name must be a constant so the index of the parameter is known at compile time.
+func (cmpl *compiler) isSet(node *parser.Node) error {
+	nFunc := node.Value.(*parser.NCallFunc)
+	if nFunc.Params == nil || len(nFunc.Params.Value.(*parser.NParams).Expr) != 1 {
+		return cmpl.Error(node, errIsSetParam)
+	}
+	par := nFunc.Params.Value.(*parser.NParams).Expr[0]
+	name, ok := par.Value.(string)
+	if par.Type != parser.TValue || !ok {
+		return cmpl.Error(node, errIsSetParam)
+	}
+	vinfo, ok := cmpl.Contract.Params[name]
+	if !ok {
+		return cmpl.ErrorParam(node, errContractNoParam, name)
+	}
+	cmpl.use(name)
+	cmpl.Append(rt.ISSET, rt.Bcode(vinfo.Index))
+	node.Result = parser.VBool
+	return nil
+}
+
+// checkRequired checks that the required parameters are passed to the called contract
+func (cmpl *compiler) checkRequired(node *parser.Node, cnt *rt.Contract) error {
+	nCallContract := node.Value.(*parser.NCallContract)
+	passed := make(map[string]bool)
+	for _, ipar := range nCallContract.Params {
+		passed[ipar.Name] = true
+	}
+	var missing []string
+	for name, attr := range cnt.Attrs {
+		if attr.Required && !passed[name] {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		sort.Strings(missing)
+		return cmpl.ErrorTwoParam(node, errContractRequired, cnt.Name, missing[0])
+	}
+	return nil
+}
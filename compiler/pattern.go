@@ -0,0 +1,23 @@
+package compiler
+
+import (
+	"strings"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// checkPattern checks the constant regular expression of Regexp functions at compile time.
+// The pattern is the first parameter of these functions.
+func (cmpl *compiler) checkPattern(eFunc rt.EmbedFunc, nFunc *parser.NCallFunc) error {
+	if !strings.HasPrefix(eFunc.Name, `Regexp`) {
+		return nil
+	}
+	expr := nFunc.Params.Value.(*parser.NParams).Expr[0]
+	if pattern, ok := expr.Value.(string); ok && expr.Type == parser.TValue {
+		if _, err := rt.CompilePattern(pattern); err != nil {
+			return cmpl.Error(expr, err.Error())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,72 @@
+package compiler
+
+import (
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// hasConditions returns true if the contract has the conditions section
+func hasConditions(node *parser.Node) bool {
+	for _, stmt := range node.Value.(*parser.NContract).Block.Value.(*parser.NBlock).Statements {
+		if stmt.Type == parser.TConditions {
+			return true
+		}
+	}
+	return false
+}
+
+// mutable is called for the calls of the mutable functions and contracts. They cannot be
+// called from the read contract and the conditions. The functions which call them are
+// marked as mutable.
+func (cmpl *compiler) mutable(node *parser.Node) error {
+	if cmpl.Contract.Read {
+		return cmpl.Error(node, errReadContract)
+	}
+	if cmpl.InFunc {
+		if cmpl.Mutable == nil {
+			cmpl.Mutable = make(map[*rt.FuncInfo]bool)
+		}
+		cmpl.Mutable[cmpl.Func] = true
+		return nil
+	}
+	if cmpl.ReadOnly {
+		return cmpl.Error(node, errReadContract)
+	}
+	return nil
+}
+
+// section compiles the conditions or the action section. VM.Validate executes the contract
+// until ACTION command which is appended after the conditions.
+func (cmpl *compiler) section(node *parser.Node) error {
+	name := `action`
+	if node.Type == parser.TConditions {
+		name = `conditions`
+	}
+	if len(cmpl.Blocks) != 1 || cmpl.InFunc {
+		return cmpl.ErrorParam(node, errSectionLevel, name)
+	}
+	if node.Type == parser.TConditions {
+		if cmpl.Contract.Conditions {
+			return cmpl.ErrorParam(node, errSectionTwice, name)
+		}
+		if cmpl.Action {
+			return cmpl.Error(node, errSectionOrder)
+		}
+		cmpl.Contract.Conditions = true
+		cmpl.InCond = true
+	} else {
+		if cmpl.Action {
+			return cmpl.ErrorParam(node, errSectionTwice, name)
+		}
+		cmpl.Action = true
+	}
+	if err := nodeToCode(node.Value.(*parser.NSection).Body, cmpl); err != nil {
+		return err
+	}
+	if node.Type == parser.TConditions {
+		cmpl.InCond = false
+		cmpl.ReadOnly = false
+		cmpl.Append(rt.ACTION)
+	}
+	return nil
+}
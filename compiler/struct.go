@@ -0,0 +1,199 @@
+package compiler
+
+import (
+	"fmt"
+	"unicode"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// structs declares the struct types of the contract before the compilation, so the data
+// parameters and the functions can use the structs which are declared below them. The types
+// of the fields can be only the structs which have been declared above.
+func (cmpl *compiler) structs(root *parser.Node) error {
+	var (
+		err   error
+		block *parser.Node
+	)
+	if nContract, ok := root.Value.(*parser.NContract); ok {
+		cmpl.Contract.Name = nContract.Name
+		block = nContract.Block
+	}
+	top := make(map[*parser.Node]bool)
+	if block != nil {
+		for _, child := range block.Value.(*parser.NBlock).Statements {
+			if child.Type != parser.TStruct {
+				continue
+			}
+			top[child] = true
+			if err = cmpl.declareStruct(child); err != nil {
+				return err
+			}
+		}
+	}
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if err != nil || node == nil {
+			return false
+		}
+		switch node.Type {
+		case parser.TStruct:
+			if !top[node] {
+				err = cmpl.ErrorBegin(node, errStructLevel)
+			}
+			// the fields have been resolved by declareStruct
+			return false
+		case parser.TType:
+			if nType := node.Value.(*parser.NType); len(nType.Name) > 0 {
+				if ind := cmpl.findStruct(nType.Name); ind >= 0 {
+					nType.Type = rt.StructType(ind)
+				} else {
+					err = cmpl.ErrorParam(node, errStructUnknown, nType.Name)
+				}
+			}
+		}
+		return true
+	})
+	return err
+}
+
+// declareStruct appends the struct type to Contract.Structs
+func (cmpl *compiler) declareStruct(node *parser.Node) error {
+	nStruct := node.Value.(*parser.NStruct)
+	if !unicode.IsUpper([]rune(nStruct.Name)[0]) {
+		return cmpl.ErrorBegin(node, fmt.Sprintf(errStructName, nStruct.Name))
+	}
+	if cmpl.findStruct(nStruct.Name) >= 0 {
+		return cmpl.ErrorBegin(node, fmt.Sprintf(errStructExists, nStruct.Name))
+	}
+	sinfo := &rt.StructInfo{
+		Name:   nStruct.Name,
+		Fields: make([]rt.Var, len(nStruct.Fields)),
+	}
+	for i, field := range nStruct.Fields {
+		if sinfo.Field(field.Name) >= 0 {
+			return cmpl.ErrorParam(field.Type, errFieldExists, field.Name)
+		}
+		nType := field.Type.Value.(*parser.NType)
+		if len(nType.Name) > 0 {
+			ind := cmpl.findStruct(nType.Name)
+			if ind < 0 {
+				return cmpl.ErrorParam(field.Type, errStructUnknown, nType.Name)
+			}
+			nType.Type = rt.StructType(ind)
+		}
+		if nType.Type == parser.VVoid {
+			return cmpl.Error(field.Type, errInvalidType)
+		}
+		sinfo.Fields[i] = rt.Var{Type: nType.Type, Name: field.Name}
+	}
+	cmpl.Contract.Structs = append(cmpl.Contract.Structs, sinfo)
+	return nil
+}
+
+// findStruct returns the index of the struct type or -1 if it hasn't been declared
+func (cmpl *compiler) findStruct(name string) int {
+	for i, sinfo := range cmpl.Contract.Structs {
+		if sinfo.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// typeName returns the name of the type, the struct types have their own names
+func (cmpl *compiler) typeName(vtype uint32) string {
+	return cmpl.Contract.StructName(int64(vtype))
+}
+
+// structField returns the struct type and the index of the field
+func (cmpl *compiler) structField(node *parser.Node) (*rt.StructInfo, int, error) {
+	nField := node.Value.(*parser.NField)
+	if err := nodeToCode(nField.Expr, cmpl); err != nil {
+		return nil, 0, err
+	}
+	vtype := int64(nField.Expr.Result)
+	if !rt.IsStruct(vtype) {
+		return nil, 0, cmpl.ErrorParam(node, errFieldType, cmpl.typeName(nField.Expr.Result))
+	}
+	sinfo := cmpl.Contract.Structs[rt.StructIndex(vtype)]
+	ind := sinfo.Field(nField.Name)
+	if ind < 0 {
+		return nil, 0, cmpl.ErrorTwoParam(node, errFieldUnknown, sinfo.Name, nField.Name)
+	}
+	return sinfo, ind, nil
+}
+
+// fieldValue compiles the value which is assigned to the field. The strings and the money
+// values are copied because the struct must not share them with the variables.
+func (cmpl *compiler) fieldValue(expr *parser.Node, field rt.Var) error {
+	if err := nodeToCode(expr, cmpl); err != nil {
+		return err
+	}
+	if int64(expr.Result) != field.Type {
+		return cmpl.ErrorTwoParam(expr, errFieldValue, field.Name,
+			cmpl.typeName(uint32(field.Type)))
+	}
+	switch expr.Result {
+	case parser.VStr:
+		cmpl.Append(rt.COPYSTR)
+	case parser.VMoney:
+		cmpl.Append(rt.COPY, parser.VMoney)
+	}
+	return nil
+}
+
+// getField compiles the access to the field like o.amount
+func (cmpl *compiler) getField(node *parser.Node) error {
+	sinfo, ind, err := cmpl.structField(node)
+	if err != nil {
+		return err
+	}
+	cmpl.Append(rt.GETFIELD, rt.Bcode(ind))
+	node.Result = uint32(sinfo.Fields[ind].Type)
+	return nil
+}
+
+// setField compiles the assignment of the field like o.amount = money(5)
+func (cmpl *compiler) setField(node *parser.Node) error {
+	nBinary := node.Value.(*parser.NBinary)
+	sinfo, ind, err := cmpl.structField(nBinary.Left)
+	if err != nil {
+		return err
+	}
+	if err = cmpl.fieldValue(nBinary.Right, sinfo.Fields[ind]); err != nil {
+		return err
+	}
+	cmpl.Append(rt.SETFIELD, rt.Bcode(ind))
+	node.Result = parser.VVoid
+	return nil
+}
+
+// structValue compiles the struct literal like Order{id: "x", amount: money(5)}. The fields
+// which are not specified get the default values.
+func (cmpl *compiler) structValue(node *parser.Node) error {
+	nValue := node.Value.(*parser.NStructValue)
+	ind := cmpl.findStruct(nValue.Name)
+	if ind < 0 {
+		return cmpl.ErrorParam(node, errStructUnknown, nValue.Name)
+	}
+	sinfo := cmpl.Contract.Structs[ind]
+	cmpl.Append(rt.NEWSTRUCT, rt.Bcode(ind))
+	used := make(map[string]bool)
+	for _, item := range nValue.Fields {
+		find := sinfo.Field(item.Name)
+		if find < 0 {
+			return cmpl.ErrorTwoParam(item.Expr, errFieldUnknown, sinfo.Name, item.Name)
+		}
+		if used[item.Name] {
+			return cmpl.ErrorParam(item.Expr, errFieldExists, item.Name)
+		}
+		used[item.Name] = true
+		if err := cmpl.fieldValue(item.Expr, sinfo.Fields[find]); err != nil {
+			return err
+		}
+		cmpl.Append(rt.INITFIELD, rt.Bcode(find))
+	}
+	node.Result = uint32(rt.StructType(ind))
+	return nil
+}
@@ -0,0 +1,72 @@
+package compiler
+
+import (
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// errorType is the type of the variable of catch, it contains code, message and origin
+const errorType = parser.VMap | parser.VStr<<4
+
+// throw compiles error(code, message) which stops the contract with the error
+func (cmpl *compiler) throw(node *parser.Node) error {
+	nFunc := node.Value.(*parser.NCallFunc)
+	if nFunc.Params == nil || len(nFunc.Params.Value.(*parser.NParams).Expr) != 2 {
+		return cmpl.Error(node, errThrowParams)
+	}
+	for _, expr := range nFunc.Params.Value.(*parser.NParams).Expr {
+		if err := nodeToCode(expr, cmpl); err != nil {
+			return err
+		}
+		if expr.Result != parser.VStr {
+			return cmpl.Error(node, errThrowParams)
+		}
+	}
+	cmpl.Append(rt.THROW)
+	node.Result = parser.VVoid
+	return nil
+}
+
+// try compiles try { } catch e { }. TRY has the offset of the catch block and the index of
+// the error variable which gets the map with code, message and origin keys.
+func (cmpl *compiler) try(node *parser.Node) error {
+	nTry := node.Value.(*parser.NTry)
+	vars := []parser.NVar{{Name: nTry.Var, Type: &parser.Node{Type: parser.TType,
+		Value: &parser.NType{Type: errorType}, Line: node.Line, Column: node.Column}}}
+	idxList, err := cmpl.InitVars(nTry.Catch, vars)
+	if err != nil {
+		return err
+	}
+	// the error variable is assigned by the runtime
+	cmpl.use(nTry.Var)
+	start := len(cmpl.Contract.Code)
+	cmpl.Append(rt.TRY, 0, idxList[0])
+	cmpl.Tries++
+	if err = nodeToCode(nTry.Body, cmpl); err != nil {
+		return err
+	}
+	cmpl.Tries--
+	cmpl.Append(rt.ENDTRY)
+	end := len(cmpl.Contract.Code)
+	cmpl.Append(rt.JMP, 0)
+	var off rt.Bcode
+	if off, err = cmpl.JumpOff(nTry.Catch, end+2-start); err != nil {
+		return err
+	}
+	cmpl.Contract.Code[start+1] = off
+	if err = nodeToCode(nTry.Catch, cmpl); err != nil {
+		return err
+	}
+	if off, err = cmpl.JumpOff(nTry.Catch, len(cmpl.Contract.Code)-end); err != nil {
+		return err
+	}
+	cmpl.Contract.Code[end+1] = off
+	return nil
+}
+
+// leaveTries removes the try blocks which are left by break or continue
+func (cmpl *compiler) leaveTries() {
+	for i := cmpl.Jumps[len(cmpl.Jumps)-1].Tries; i < cmpl.Tries; i++ {
+		cmpl.Append(rt.ENDTRY)
+	}
+}
@@ -0,0 +1,115 @@
+package compiler
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+// funcResults returns the types of the multiple results of the function
+func (cmpl *compiler) funcResults(nFunc *parser.NFunc) ([]int64, error) {
+	if len(nFunc.Results) == 0 {
+		return nil, nil
+	}
+	ret := make([]int64, len(nFunc.Results))
+	for i, item := range nFunc.Results {
+		ret[i] = item.Value.(*parser.NType).Type
+		if ret[i] == parser.VVoid {
+			return nil, cmpl.Error(item, errInvalidType)
+		}
+	}
+	return ret, nil
+}
+
+// resultsName returns the types of the multiple results like (int, str)
+func resultsName(results []int64) string {
+	names := make([]string, len(results))
+	for i, vtype := range results {
+		names[i] = Type2Str(uint32(vtype))
+	}
+	return `(` + strings.Join(names, `, `) + `)`
+}
+
+// returnList compiles return of the function with multiple results. The values are left
+// on the stack in the declaration order, so the caller gets them with GETPARAMS.
+func (cmpl *compiler) returnList(node *parser.Node) error {
+	nReturn := node.Value.(*parser.NReturn)
+	if !cmpl.InFunc {
+		return cmpl.Error(node, errReturnList)
+	}
+	results := cmpl.Func.Results
+	if len(results) == 0 {
+		if cmpl.RetFunc == parser.VVoid {
+			return cmpl.Error(node, errNotReturn)
+		}
+		return cmpl.ErrorParam(node, errReturnType, Type2Str(uint32(cmpl.RetFunc)))
+	}
+	list := nReturn.List
+	if nReturn.Expr != nil {
+		list = []*parser.Node{nReturn.Expr}
+	}
+	if len(list) != len(results) {
+		return cmpl.ErrorParam(node, errResultCount, len(results))
+	}
+	for i, expr := range list {
+		if err := nodeToCode(expr, cmpl); err != nil {
+			return err
+		}
+		if int64(expr.Result) != results[i] {
+			return cmpl.ErrorParam(expr, errReturnType, resultsName(results))
+		}
+		// the caller must not share the string or money with the variables of the function
+		switch expr.Result {
+		case parser.VStr:
+			cmpl.Append(rt.COPYSTR)
+		case parser.VMoney:
+			cmpl.Append(rt.COPY, parser.VMoney)
+		}
+	}
+	cmpl.Append(rt.RETFUNC)
+	return nil
+}
+
+// multiAssign compiles the assignment of the multiple results like q, r = divmod(a, b)
+func (cmpl *compiler) multiAssign(node *parser.Node) error {
+	nAssign := node.Value.(*parser.NMultiAssign)
+	if nAssign.Expr.Type != parser.TCallFunc {
+		return cmpl.Error(nAssign.Expr, errMultiExpr)
+	}
+	nFunc := nAssign.Expr.Value.(*parser.NCallFunc)
+	cmpl.Tuple = true
+	err := nodeToCode(nAssign.Expr, cmpl)
+	cmpl.Tuple = false
+	if err != nil {
+		return err
+	}
+	var results []int64
+	if code, _ := cmpl.findCallFunc(nFunc); code != rt.NOP && code < EMBEDDED {
+		results = cmpl.Contract.Funcs[code-1].Results
+	}
+	if len(results) != len(nAssign.Vars) {
+		count := len(results)
+		if count == 0 && nAssign.Expr.Result != parser.VVoid {
+			count = 1
+		}
+		return cmpl.Error(node, fmt.Sprintf(errMultiAssign, len(nAssign.Vars), nFunc.Name, count))
+	}
+	idxList := make([]rt.Bcode, len(nAssign.Vars))
+	for i, item := range nAssign.Vars {
+		name := item.Value.(*parser.NVarValue).Name
+		vinfo, ok := cmpl.Contract.Vars[name]
+		if !ok {
+			return cmpl.ErrorParam(item, errVarUnknown, name)
+		}
+		if int64(vinfo.Type) != results[i] {
+			return cmpl.ErrorTwoParam(item, errMultiType, name, Type2Str(uint32(results[i])))
+		}
+		// GETPARAMS pops the values, so the last variable is the first one
+		idxList[len(idxList)-1-i] = rt.Bcode(vinfo.Index)
+	}
+	cmpl.Append(rt.GETPARAMS, rt.Bcode(len(idxList)))
+	cmpl.Append(idxList...)
+	return nil
+}
@@ -0,0 +1,135 @@
+package simvolio
+
+import (
+	"fmt"
+
+	"github.com/shelmesky/bvm/compiler"
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/runtime"
+)
+
+const (
+	errCntSource    = `The source of contract %s is unknown`
+	errCntDependent = `Contract %s cannot be reloaded: %v`
+)
+
+// contractFile is the source of the version of the linked contract. It is used to recompile
+// the contract when the called contract has been changed.
+type contractFile struct {
+	File string
+	Text string // the source of the file which can contain several contracts
+}
+
+// Dependents returns the contracts which call the current version of the contract. The old
+// versions of the contracts are included if they are called by the pinned calls.
+func (vm *VM) Dependents(name string) []*runtime.Contract {
+	var ret []*runtime.Contract
+	used := make(map[*runtime.Contract]bool)
+	for _, cnt := range vm.Contracts {
+		if cnt == nil || used[cnt] {
+			continue
+		}
+		used[cnt] = true
+		for _, call := range cnt.Calls {
+			if call == name {
+				ret = append(ret, cnt)
+				break
+			}
+		}
+	}
+	return ret
+}
+
+// compatible returns true if the callers of the old contract can call the new one without
+// recompilation. The callers have the indexes and the types of the parameters in the bytecode.
+// The functions of the library are compiled into the importing contracts so they are always
+// recompiled.
+func compatible(old, cnt *runtime.Contract) bool {
+	if old.Library || cnt.Library {
+		return false
+	}
+	// the read callers cannot call the mutable contract
+	if old.Read && !cnt.Read {
+		return false
+	}
+	for name, par := range old.Params {
+		if newPar, ok := cnt.Params[name]; !ok || newPar != par {
+			return false
+		}
+	}
+	// the callers are checked for the required parameters
+	for name, attr := range cnt.Attrs {
+		if attr.Required && (old.Attrs[name] == nil || !old.Attrs[name].Required) {
+			return false
+		}
+	}
+	return true
+}
+
+// fileError adds the name of the file to the error
+func fileError(file string, err error) error {
+	if len(file) == 0 {
+		return err
+	}
+	if cerr, ok := err.(*compiler.Error); ok {
+		cerr.File = file
+		return cerr
+	}
+	return fmt.Errorf(`%s: %v`, file, err)
+}
+
+// recompile compiles the version of the linked contract again
+func (vm *VM) recompile(cnt *runtime.Contract) (*runtime.Contract, error) {
+	item := vm.version(cnt.Name, cnt.Version)
+	if item == nil || len(item.Source.Text) == 0 {
+		return nil, fmt.Errorf(errCntSource, cnt.Name)
+	}
+	roots, err := parser.ParseFile(item.Source.File, item.Source.Text)
+	if err != nil {
+		return nil, err
+	}
+	for _, root := range roots {
+		if root.Value.(*parser.NContract).Name == cnt.Name {
+			count := len(vm.Contracts)
+			pinned := vm.pin(root)
+			ret, err := compiler.CompileNode(root, &vm.NameSpace, &vm.Contracts, vm.Custom)
+			if err != nil {
+				vm.unpin(count, pinned)
+				return nil, fileError(item.Source.File, err)
+			}
+			librarySource(ret, item.Source.Text)
+			ret.ID = cnt.ID
+			ret.Version = cnt.Version
+			return ret, nil
+		}
+	}
+	return nil, fmt.Errorf(errCntSource, cnt.Name)
+}
+
+// relink replaces the linked contract with the new version. If the parameters have been
+// changed then the dependent contracts are recompiled. The contract isn't replaced if some
+// dependent contract cannot be compiled.
+func (vm *VM) relink(ind uint32, cnt *runtime.Contract) error {
+	old := vm.Contracts[ind]
+	cnt.ID = old.ID
+	vm.Contracts[ind] = cnt
+	if compatible(old, cnt) {
+		return nil
+	}
+	var list []*runtime.Contract
+	for _, dep := range vm.Dependents(cnt.Name) {
+		if dep == cnt {
+			continue
+		}
+		recompiled, err := vm.recompile(dep)
+		if err != nil {
+			vm.Contracts[ind] = old
+			return fmt.Errorf(errCntDependent, cnt.Name, err)
+		}
+		list = append(list, recompiled)
+	}
+	for _, dep := range list {
+		vm.replace(dep)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package simvolio
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/shelmesky/bvm/compiler"
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/runtime"
+)
+
+// ContractExt is the extension of the contract files which are loaded by LoadDir
+const ContractExt = `.contract`
+
+const (
+	errCntDefined = `%s: Contract %s has already been defined`
+	errCntTwice   = `%s: Contract %s has already been defined in %s`
+	errCntCycle   = `Contracts have cyclic dependency %s`
+)
+
+// contractSource is the parsed contract of the source file
+type contractSource struct {
+	File string
+	Text string // the source of the file
+	Name string
+	Root *parser.Node
+	Deps []string // the names of the called contracts and the imported libraries
+}
+
+// dependencies returns the names of the contracts which are called by the contract and the
+// names of the imported libraries
+func dependencies(root *parser.Node) []string {
+	var deps []string
+	used := make(map[string]bool)
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if node == nil {
+			return false
+		}
+		var name string
+		switch node.Type {
+		case parser.TCallContract:
+			name = node.Value.(*parser.NCallContract).Name
+		case parser.TImport:
+			name = node.Value.(*parser.NImport).Name
+		default:
+			return true
+		}
+		if !used[name] {
+			used[name] = true
+			deps = append(deps, name)
+		}
+		return true
+	})
+	return deps
+}
+
+// LoadDir compiles and links the contracts of the files with ContractExt extension in the
+// directory. The files are processed in the alphabetical order.
+func (vm *VM) LoadDir(dir string) ([]*runtime.Contract, error) {
+	files, err := filepath.Glob(filepath.Join(dir, `*`+ContractExt))
+	if err != nil {
+		return nil, err
+	}
+	return vm.LoadFiles(files...)
+}
+
+// LoadFiles compiles and links the contracts of the source files. Each file can contain
+// several contracts. The called contracts are compiled before the calling contracts
+// regardless of the order of the files. The contracts are returned in the source order,
+// ID of the contract is its index in vm.Contracts. If there is an error then none of the
+// contracts is linked.
+func (vm *VM) LoadFiles(filenames ...string) ([]*runtime.Contract, error) {
+	names := make([]string, 0, len(filenames))
+	sources := make([]string, 0, len(filenames))
+	for _, filename := range filenames {
+		content, err := ioutil.ReadFile(filename)
+		if err != nil {
+			return nil, err
+		}
+		// the lexer expects \r\n at the end of lines
+		lines := strings.Split(string(content), "\n")
+		for i, line := range lines {
+			lines[i] = strings.TrimRight(line, "\r")
+		}
+		names = append(names, filename)
+		sources = append(sources, strings.Join(lines, "\r\n"))
+	}
+	return vm.loadSources(names, sources)
+}
+
+func (vm *VM) loadSources(filenames, sources []string) ([]*runtime.Contract, error) {
+	var list []*contractSource
+	byName := make(map[string]*contractSource)
+	for i, filename := range filenames {
+		roots, err := parser.ParseFile(filename, sources[i])
+		if err != nil {
+			return nil, err
+		}
+		for _, root := range roots {
+			src := &contractSource{
+				File: filename,
+				Text: sources[i],
+				Name: root.Value.(*parser.NContract).Name,
+				Root: root,
+				Deps: dependencies(root),
+			}
+			if prev, ok := byName[src.Name]; ok {
+				return nil, fmt.Errorf(errCntTwice, filename, src.Name, prev.File)
+			}
+			if _, ok := vm.NameSpace[src.Name]; ok {
+				return nil, fmt.Errorf(errCntDefined, filename, src.Name)
+			}
+			byName[src.Name] = src
+			list = append(list, src)
+		}
+	}
+	order, err := sortSources(list, byName)
+	if err != nil {
+		return nil, err
+	}
+
+	// the versions of the linked contracts are pinned before the rollback point, they are
+	// removed if some contract cannot be loaded
+	pinCount := len(vm.Contracts)
+	var pinned []string
+	for _, src := range order {
+		pinned = append(pinned, vm.pin(src.Root)...)
+	}
+	count := len(vm.Contracts)
+	contracts := make(map[string]*runtime.Contract)
+	for _, src := range order {
+		cnt, err := compiler.CompileNode(src.Root, &vm.NameSpace, &vm.Contracts, vm.Custom)
+		if err == nil {
+			librarySource(cnt, src.Text)
+			err = vm.Link(cnt, false)
+		}
+		if err != nil {
+			// the linked contracts are removed
+			for _, cnt := range vm.Contracts[count:] {
+				delete(vm.NameSpace, cnt.Name)
+				for i := range vm.versions[cnt.Name] {
+					delete(vm.NameSpace, parser.VersionName(cnt.Name, i+1))
+				}
+				delete(vm.versions, cnt.Name)
+			}
+			vm.unpin(pinCount, pinned)
+			return nil, fileError(src.File, err)
+		}
+		cnt.ID = int64(vm.NameSpace[cnt.Name])
+		contracts[cnt.Name] = cnt
+	}
+	ret := make([]*runtime.Contract, len(list))
+	for i, src := range list {
+		ret[i] = contracts[src.Name]
+		vm.setSource(src.Name, src.File, src.Text)
+	}
+	return ret, nil
+}
+
+// sortSources returns the contracts in the topological order, the called contracts go first.
+// The contracts which have not been loaded are skipped, they must be linked to VM before.
+func sortSources(list []*contractSource, byName map[string]*contractSource) ([]*contractSource,
+	error) {
+	const (
+		visiting = iota + 1
+		visited
+	)
+	var (
+		order []*contractSource
+		path  []*contractSource
+		visit func(src *contractSource) error
+	)
+	state := make(map[*contractSource]int)
+	visit = func(src *contractSource) error {
+		switch state[src] {
+		case visiting:
+			var chain []string
+			for i := len(path) - 1; i >= 0; i-- {
+				chain = append([]string{fmt.Sprintf(`%s (%s)`, path[i].Name, path[i].File)}, chain...)
+				if path[i] == src {
+					break
+				}
+			}
+			return fmt.Errorf(errCntCycle, strings.Join(append(chain, src.Name), ` -> `))
+		case visited:
+			return nil
+		}
+		state[src] = visiting
+		path = append(path, src)
+		for _, name := range src.Deps {
+			if dep, ok := byName[name]; ok {
+				if err := visit(dep); err != nil {
+					return err
+				}
+			}
+		}
+		path = path[:len(path)-1]
+		state[src] = visited
+		order = append(order, src)
+		return nil
+	}
+	for _, src := range list {
+		if err := visit(src); err != nil {
+			return nil, err
+		}
+	}
+	return order, nil
+}
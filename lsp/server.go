@@ -0,0 +1,316 @@
+// Package lsp implements the Language Server Protocol for the contract language.
+// The server works over stdio and uses the parser and the compiler of VM for
+// diagnostics, hover, go-to-definition and completion.
+package lsp
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shelmesky/bvm"
+	"github.com/shelmesky/bvm/compiler"
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+const (
+	errHeader = `invalid header %s`
+	errLength = `Content-Length is missing`
+	errMethod = `method %s is not supported`
+)
+
+// document is an opened file with a contract
+type document struct {
+	URI      string
+	Lines    []string // the lines without line endings
+	Source   string   // the source for the compiler
+	Tokens   []parser.Token
+	Funcs    []*parser.NFunc
+	Contract *rt.Contract // the last successfully compiled contract
+	Failed   bool         // the contract has not been compiled
+	UTF8     bool         // the positions are in bytes instead of UTF-16 units
+}
+
+// Server is a language server. The compiled contracts of the opened documents are linked
+// to VM so they can be called from other documents.
+type Server struct {
+	VM        *simvolio.VM
+	Documents map[string]*document
+	Encoding  string // the negotiated encoding of the positions, utf-16 by default
+	out       io.Writer
+	exit      bool
+}
+
+// NewServer creates a new language server
+func NewServer(vm *simvolio.VM) *Server {
+	return &Server{
+		VM:        vm,
+		Documents: make(map[string]*document),
+	}
+}
+
+// Serve reads the requests from in and writes the responses to out until exit notification
+// or the end of the input
+func (s *Server) Serve(in io.Reader, out io.Writer) error {
+	s.out = out
+	reader := bufio.NewReader(in)
+	for !s.exit {
+		body, err := readMessage(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+		var req request
+		if err = json.Unmarshal(body, &req); err != nil {
+			if err = s.replyError(nil, codeParseError, err.Error()); err != nil {
+				return err
+			}
+			continue
+		}
+		if err = s.handle(&req); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func readMessage(reader *bufio.Reader) ([]byte, error) {
+	length := -1
+	for {
+		line, err := reader.ReadString('\n')
+		if err != nil {
+			return nil, err
+		}
+		line = strings.TrimSpace(line)
+		if len(line) == 0 {
+			break
+		}
+		colon := strings.IndexByte(line, ':')
+		if colon < 0 {
+			return nil, fmt.Errorf(errHeader, line)
+		}
+		if strings.EqualFold(line[:colon], `Content-Length`) {
+			if length, err = strconv.Atoi(strings.TrimSpace(line[colon+1:])); err != nil {
+				return nil, fmt.Errorf(errHeader, line)
+			}
+		}
+	}
+	if length < 0 {
+		return nil, fmt.Errorf(errLength)
+	}
+	body := make([]byte, length)
+	_, err := io.ReadFull(reader, body)
+	return body, err
+}
+
+func (s *Server) write(msg interface{}) error {
+	body, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(s.out, "Content-Length: %d\r\n\r\n%s", len(body), body)
+	return err
+}
+
+func (s *Server) reply(id *json.RawMessage, result interface{}) error {
+	return s.write(response{JSONRPC: `2.0`, ID: id, Result: result})
+}
+
+func (s *Server) replyError(id *json.RawMessage, code int, message string) error {
+	return s.write(errorResponse{JSONRPC: `2.0`, ID: id, Error: responseError{Code: code,
+		Message: message}})
+}
+
+func (s *Server) notify(method string, params interface{}) error {
+	return s.write(notification{JSONRPC: `2.0`, Method: method, Params: params})
+}
+
+func (s *Server) handle(req *request) error {
+	var pos positionParams
+	switch req.Method {
+	case `textDocument/hover`, `textDocument/definition`, `textDocument/completion`:
+		if err := json.Unmarshal(req.Params, &pos); err != nil {
+			return s.replyError(req.ID, codeInvalidParams, err.Error())
+		}
+	}
+	switch req.Method {
+	case `initialize`:
+		var params initializeParams
+		json.Unmarshal(req.Params, &params)
+		s.Encoding = encodingUTF16
+		for _, encoding := range params.Capabilities.General.PositionEncodings {
+			if encoding == encodingUTF8 {
+				s.Encoding = encodingUTF8
+			}
+		}
+		return s.reply(req.ID, initializeResult{
+			Capabilities: serverCapabilities{
+				PositionEncoding:   s.Encoding,
+				TextDocumentSync:   1,
+				HoverProvider:      true,
+				DefinitionProvider: true,
+				CompletionProvider: completionOptions{
+					TriggerCharacters: []string{`$`, `@`, `(`},
+				},
+			},
+			ServerInfo: serverInfo{Name: `bvm`},
+		})
+	case `shutdown`:
+		return s.reply(req.ID, nil)
+	case `exit`:
+		s.exit = true
+	case `textDocument/didOpen`:
+		var params didOpenParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil
+		}
+		return s.update(params.TextDocument.URI, params.TextDocument.Text)
+	case `textDocument/didChange`:
+		var params didChangeParams
+		if err := json.Unmarshal(req.Params, &params); err != nil || len(params.ContentChanges) == 0 {
+			return nil
+		}
+		return s.update(params.TextDocument.URI,
+			params.ContentChanges[len(params.ContentChanges)-1].Text)
+	case `textDocument/didClose`:
+		var params didCloseParams
+		if err := json.Unmarshal(req.Params, &params); err != nil {
+			return nil
+		}
+		delete(s.Documents, params.TextDocument.URI)
+		return s.notify(`textDocument/publishDiagnostics`, publishDiagnosticsParams{
+			URI: params.TextDocument.URI, Diagnostics: []Diagnostic{}})
+	case `textDocument/hover`:
+		if doc := s.Documents[pos.TextDocument.URI]; doc != nil {
+			if hover := s.hover(doc, pos.Position); hover != nil {
+				return s.reply(req.ID, hover)
+			}
+		}
+		return s.reply(req.ID, nil)
+	case `textDocument/definition`:
+		if doc := s.Documents[pos.TextDocument.URI]; doc != nil {
+			if loc := s.definition(doc, pos.Position); loc != nil {
+				return s.reply(req.ID, loc)
+			}
+		}
+		return s.reply(req.ID, nil)
+	case `textDocument/completion`:
+		items := []CompletionItem{}
+		if doc := s.Documents[pos.TextDocument.URI]; doc != nil {
+			items = append(items, s.completion(doc, pos.Position)...)
+		}
+		return s.reply(req.ID, items)
+	default:
+		// the unknown notifications are ignored
+		if req.ID != nil {
+			return s.replyError(req.ID, codeMethodNotFound, fmt.Sprintf(errMethod, req.Method))
+		}
+	}
+	return nil
+}
+
+// update saves the new text of the document and publishes the diagnostics. The documents
+// which have not been compiled are checked again because they can call the updated contract.
+func (s *Server) update(uri, text string) error {
+	doc := &document{
+		URI:   uri,
+		Lines: strings.Split(text, "\n"),
+		UTF8:  s.Encoding == encodingUTF8,
+	}
+	for i, line := range doc.Lines {
+		doc.Lines[i] = strings.TrimRight(line, "\r")
+	}
+	// the lexer expects \r\n at the end of lines
+	doc.Source = strings.Join(doc.Lines, "\r\n")
+	doc.Tokens, _ = parser.Tokens(doc.Source)
+	prev := s.Documents[uri]
+	if root, err := parser.Parser(doc.Source); err == nil {
+		doc.Funcs = funcs(root)
+	} else if prev != nil {
+		doc.Funcs = prev.Funcs
+	}
+	if prev != nil {
+		doc.Contract = prev.Contract
+	}
+	s.Documents[uri] = doc
+	if err := s.publish(doc); err != nil {
+		return err
+	}
+	for _, other := range s.sortedDocuments() {
+		if other != doc && other.Failed && !doc.Failed {
+			if err := s.publish(other); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+func (s *Server) sortedDocuments() []*document {
+	ret := make([]*document, 0, len(s.Documents))
+	for _, doc := range s.Documents {
+		ret = append(ret, doc)
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].URI < ret[j].URI
+	})
+	return ret
+}
+
+// publish compiles the document and sends its diagnostics
+func (s *Server) publish(doc *document) error {
+	return s.notify(`textDocument/publishDiagnostics`, publishDiagnosticsParams{
+		URI:         doc.URI,
+		Diagnostics: s.check(doc),
+	})
+}
+
+func (s *Server) check(doc *document) []Diagnostic {
+	diagnostics := []Diagnostic{}
+	cnt, warnings, err := s.VM.AnalyzeContract(doc.Source)
+	doc.Failed = err != nil
+	if err != nil {
+		var (
+			line, column int
+			text         = err.Error()
+		)
+		switch e := err.(type) {
+		case *parser.Error:
+			line, column, text = e.Line, e.Column, e.Text
+		case *compiler.Error:
+			line, column, text = e.Line, int(e.Column), e.Text
+		}
+		return append(diagnostics, Diagnostic{
+			Range:    doc.tokenRange(line, column),
+			Severity: severityError,
+			Source:   `bvm`,
+			Message:  text,
+		})
+	}
+	for _, warning := range warnings {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    doc.tokenRange(warning.Line, int(warning.Column)),
+			Severity: severityWarning,
+			Source:   `bvm`,
+			Message:  warning.Text,
+		})
+	}
+	doc.Contract = cnt
+	// the contract is not linked if the dependent contracts cannot be recompiled
+	if err = s.VM.LinkSource(cnt, s.VM.GetContract(cnt.Name) != nil, doc.URI, doc.Source); err != nil {
+		diagnostics = append(diagnostics, Diagnostic{
+			Range:    doc.nameRange(cnt.Name),
+			Severity: severityError,
+			Source:   `bvm`,
+			Message:  err.Error(),
+		})
+	}
+	return diagnostics
+}
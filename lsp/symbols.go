@@ -0,0 +1,453 @@
+package lsp
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"unicode/utf16"
+
+	"github.com/shelmesky/bvm/compiler"
+	"github.com/shelmesky/bvm/parser"
+	rt "github.com/shelmesky/bvm/runtime"
+)
+
+const kindClass = 7 // the kind of completion items for contracts
+
+// byteColumn converts the character offset of the client to the byte offset in the line.
+// The offset is counted in UTF-16 units unless UTF-8 has been negotiated.
+func (doc *document) byteColumn(line string, character int) int {
+	if doc.UTF8 {
+		if character > len(line) {
+			return len(line)
+		}
+		return character
+	}
+	var units int
+	for i, r := range line {
+		if units >= character {
+			return i
+		}
+		units += utf16.RuneLen(r)
+	}
+	return len(line)
+}
+
+// character converts the byte offset in the line to the character offset of the client
+func (doc *document) character(line string, column int) int {
+	if column > len(line) {
+		column = len(line)
+	}
+	if column < 0 {
+		column = 0
+	}
+	if doc.UTF8 {
+		return column
+	}
+	return len(utf16.Encode([]rune(line[:column])))
+}
+
+// position converts one-based line and byte column to the position of LSP
+func (doc *document) position(line, column int) Position {
+	if line < 1 {
+		line, column = 1, 1
+	}
+	if line > len(doc.Lines) {
+		line = len(doc.Lines)
+	}
+	return Position{Line: line - 1, Character: doc.character(doc.Lines[line-1], column-1)}
+}
+
+func (doc *document) rangeOf(token parser.Token) Range {
+	return Range{
+		Start: doc.position(token.Line, token.Column),
+		End:   doc.position(token.Line, token.End),
+	}
+}
+
+// tokenRange returns the range of the token at the position or one character
+func (doc *document) tokenRange(line, column int) Range {
+	for _, token := range doc.Tokens {
+		if token.Line == line && token.Column <= column && column < token.End &&
+			token.Type != parser.NEWLINE {
+			return doc.rangeOf(token)
+		}
+	}
+	// the position after the end of the line is replaced with the last token of the line
+	if line >= 1 && line <= len(doc.Lines) && column > len(doc.Lines[line-1]) {
+		for i := len(doc.Tokens) - 1; i >= 0; i-- {
+			if token := doc.Tokens[i]; token.Line == line && token.Type != parser.NEWLINE {
+				return doc.rangeOf(token)
+			}
+		}
+	}
+	return Range{Start: doc.position(line, column), End: doc.position(line, column+1)}
+}
+
+// nameRange returns the range of the first token with the name
+func (doc *document) nameRange(name string) Range {
+	for _, token := range doc.Tokens {
+		if token.Text == name {
+			return doc.rangeOf(token)
+		}
+	}
+	return doc.tokenRange(1, 1)
+}
+
+func (doc *document) location(token parser.Token) *Location {
+	return &Location{URI: doc.URI, Range: doc.rangeOf(token)}
+}
+
+// tokenAt returns the index of the token under the cursor or -1
+func (doc *document) tokenAt(pos Position) int {
+	if pos.Line < 0 || pos.Line >= len(doc.Lines) {
+		return -1
+	}
+	line := pos.Line + 1
+	column := doc.byteColumn(doc.Lines[pos.Line], pos.Character) + 1
+	ret := -1
+	for i, token := range doc.Tokens {
+		if token.Line != line || token.Column > column || column > token.End {
+			continue
+		}
+		if column < token.End {
+			return i
+		}
+		// the cursor is just after the token
+		ret = i
+	}
+	return ret
+}
+
+// prevType returns the type of the previous token
+func (doc *document) prevType(i int) int {
+	if i > 0 {
+		return doc.Tokens[i-1].Type
+	}
+	return 0
+}
+
+// funcs returns the functions defined in the blocks of the node
+func funcs(node *parser.Node) (ret []*parser.NFunc) {
+	if node == nil {
+		return
+	}
+	switch node.Type {
+	case parser.TContract:
+		return funcs(node.Value.(*parser.NContract).Block)
+	case parser.TBlock:
+		for _, child := range node.Value.(*parser.NBlock).Statements {
+			ret = append(ret, funcs(child)...)
+		}
+	case parser.TFunc:
+		ret = append(ret, node.Value.(*parser.NFunc))
+	}
+	return
+}
+
+// declaration returns the token of the variable declaration. The variables of the contract
+// cannot be redefined so the first identifier is the declaration.
+func (doc *document) declaration(name string) *parser.Token {
+	for i, token := range doc.Tokens {
+		if (token.Type != parser.IDENT && token.Type != parser.INDEX) || token.Text != name ||
+			doc.prevType(i) == parser.CONTRACT {
+			continue
+		}
+		// the names of contract parameters and object keys
+		if i+1 < len(doc.Tokens) && doc.Tokens[i+1].Type == parser.COLON {
+			continue
+		}
+		return &doc.Tokens[i]
+	}
+	return nil
+}
+
+// contractDecl returns the token of the contract name
+func (doc *document) contractDecl(name string) *parser.Token {
+	for i, token := range doc.Tokens {
+		if token.Type == parser.IDENT && token.Text == name && doc.prevType(i) == parser.CONTRACT {
+			return &doc.Tokens[i]
+		}
+	}
+	return nil
+}
+
+// callContract returns the name of the called contract if the cursor is inside its parameters
+func (doc *document) callContract(line, column int) (string, bool) {
+	var (
+		last  = -1
+		depth int
+	)
+	for i, token := range doc.Tokens {
+		if token.Line < line || (token.Line == line && token.Column < column) {
+			last = i
+		}
+	}
+	for i := last; i >= 0; i-- {
+		switch token := doc.Tokens[i]; token.Type {
+		case parser.RPAREN:
+			depth++
+		case parser.LPAREN, parser.CALL, parser.CALLCONTRACT:
+			if depth == 0 {
+				return token.Text, token.Type == parser.CALLCONTRACT
+			}
+			depth--
+		case parser.NEWLINE, parser.LBRACE, parser.RBRACE:
+			return ``, false
+		}
+	}
+	return ``, false
+}
+
+func typesToStr(list []uint32) string {
+	ret := make([]string, len(list))
+	for i, itype := range list {
+		ret[i] = compiler.Type2Str(itype)
+	}
+	return strings.Join(ret, `, `)
+}
+
+func signature(name string, params string, result uint32) string {
+	ret := fmt.Sprintf(`%s(%s)`, name, params)
+	if result != parser.VVoid {
+		ret += ` ` + compiler.Type2Str(result)
+	}
+	return ret
+}
+
+// signatures returns the signatures of the contract functions, StdLib and custom functions
+func (s *Server) signatures(doc *document, name string) (ret []string) {
+	for _, nFunc := range doc.Funcs {
+		if nFunc.Name != name {
+			continue
+		}
+		params := make([]string, len(nFunc.Params))
+		for i, par := range nFunc.Params {
+			params[i] = compiler.Type2Str(uint32(par.Type.Value.(*parser.NType).Type)) + ` ` + par.Name
+		}
+		result := uint32(parser.VVoid)
+		if nFunc.Result != nil {
+			result = uint32(nFunc.Result.Value.(*parser.NType).Type)
+		}
+		sign := `func ` + signature(name, strings.Join(params, `, `), result)
+		if len(nFunc.Results) > 0 {
+			results := make([]string, len(nFunc.Results))
+			for i, item := range nFunc.Results {
+				results[i] = compiler.Type2Str(uint32(item.Value.(*parser.NType).Type))
+			}
+			sign += ` (` + strings.Join(results, `, `) + `)`
+		}
+		ret = append(ret, sign)
+	}
+	for _, eFunc := range rt.StdLib {
+		if eFunc.Name == name {
+			ret = append(ret, signature(name, typesToStr(eFunc.PTypes), eFunc.Result))
+		}
+	}
+	if s.VM.Custom != nil {
+		for _, fItem := range s.VM.Custom.Funcs {
+			if fItem.Name == name {
+				ret = append(ret, signature(name, typesToStr(fItem.Params), fItem.Result))
+			}
+		}
+	}
+	return
+}
+
+// sortedParams returns the data parameters of the contract in the order of declaration
+func sortedParams(cnt *rt.Contract) []string {
+	names := make([]string, 0, len(cnt.Params))
+	for name := range cnt.Params {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return cnt.Params[names[i]].Index < cnt.Params[names[j]].Index
+	})
+	return names
+}
+
+func (s *Server) contractInfo(name string) string {
+	cnt := s.VM.GetContract(name)
+	if cnt == nil {
+		return ``
+	}
+	ret := `contract ` + cnt.Name
+	if cnt.Read {
+		ret += ` read`
+	}
+	if len(cnt.Params) > 0 {
+		ret += "\ndata {"
+		for _, par := range sortedParams(cnt) {
+			ret += fmt.Sprintf("\n    %s %s", compiler.Type2Str(uint32(cnt.Params[par].Type)), par)
+		}
+		ret += "\n}"
+	}
+	return ret
+}
+
+func (doc *document) varInfo(name string) string {
+	if doc.Contract == nil {
+		return ``
+	}
+	if info, ok := doc.Contract.Params[name]; ok {
+		return fmt.Sprintf(`%s %s (data parameter)`, compiler.Type2Str(uint32(info.Type)), name)
+	}
+	if info, ok := doc.Contract.Vars[name]; ok {
+		return fmt.Sprintf(`%s %s`, compiler.Type2Str(uint32(info.Type)), name)
+	}
+	return ``
+}
+
+func (s *Server) hover(doc *document, pos Position) *Hover {
+	i := doc.tokenAt(pos)
+	if i < 0 {
+		return nil
+	}
+	var text string
+	token := doc.Tokens[i]
+	switch token.Type {
+	case parser.IDENT, parser.INDEX:
+		if doc.prevType(i) == parser.CONTRACT {
+			text = s.contractInfo(token.Text)
+		} else {
+			text = doc.varInfo(token.Text)
+		}
+	case parser.CALL:
+		text = strings.Join(s.signatures(doc, token.Text), "\n")
+	case parser.CALLCONTRACT:
+		text = s.contractInfo(token.Text)
+	case parser.ENV:
+		if item, ok := s.VM.Custom.Env[token.Text]; ok {
+			text = fmt.Sprintf(`$%s %s`, token.Text, compiler.Type2Str(item.Type))
+		}
+	}
+	if len(text) == 0 {
+		return nil
+	}
+	r := doc.rangeOf(token)
+	return &Hover{Contents: MarkupContent{Kind: `plaintext`, Value: text}, Range: &r}
+}
+
+func (s *Server) definition(doc *document, pos Position) *Location {
+	i := doc.tokenAt(pos)
+	if i < 0 {
+		return nil
+	}
+	token := doc.Tokens[i]
+	switch token.Type {
+	case parser.IDENT, parser.INDEX:
+		if doc.prevType(i) == parser.CONTRACT {
+			return doc.location(token)
+		}
+		if doc.Contract != nil && len(doc.varInfo(token.Text)) == 0 {
+			return nil
+		}
+		if decl := doc.declaration(token.Text); decl != nil {
+			return doc.location(*decl)
+		}
+	case parser.CALL:
+		for j, item := range doc.Tokens {
+			if item.Type == parser.CALL && item.Text == token.Text && doc.prevType(j) == parser.FUNC {
+				return doc.location(item)
+			}
+		}
+	case parser.CALLCONTRACT:
+		for _, other := range s.sortedDocuments() {
+			if decl := other.contractDecl(token.Text); decl != nil {
+				return other.location(*decl)
+			}
+		}
+	}
+	return nil
+}
+
+func isIdentChar(ch byte) bool {
+	return ch == '_' || ch >= 0x80 || (ch >= '0' && ch <= '9') || (ch >= 'a' && ch <= 'z') ||
+		(ch >= 'A' && ch <= 'Z')
+}
+
+func (s *Server) completion(doc *document, pos Position) []CompletionItem {
+	if pos.Line < 0 || pos.Line >= len(doc.Lines) {
+		return nil
+	}
+	line := doc.Lines[pos.Line]
+	column := doc.byteColumn(line, pos.Character)
+	start := column
+	for start > 0 && isIdentChar(line[start-1]) {
+		start--
+	}
+	if start > 0 {
+		switch line[start-1] {
+		case '$':
+			return s.envItems()
+		case '@':
+			return s.contractItems()
+		}
+	}
+	if name, ok := doc.callContract(pos.Line+1, column+1); ok {
+		return s.paramItems(name)
+	}
+	return s.funcItems(doc)
+}
+
+func (s *Server) envItems() []CompletionItem {
+	ret := make([]CompletionItem, 0, len(s.VM.Custom.Env))
+	for name, item := range s.VM.Custom.Env {
+		ret = append(ret, CompletionItem{Label: name, Kind: kindVariable,
+			Detail: compiler.Type2Str(item.Type)})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Label < ret[j].Label
+	})
+	return ret
+}
+
+func (s *Server) contractItems() []CompletionItem {
+	ret := make([]CompletionItem, 0, len(s.VM.Contracts))
+	for i, cnt := range s.VM.Contracts {
+		// the unlinked contracts and the pinned versions are skipped
+		if cnt != nil && s.VM.NameSpace[cnt.Name] == uint32(i) {
+			ret = append(ret, CompletionItem{Label: cnt.Name, Kind: kindClass})
+		}
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Label < ret[j].Label
+	})
+	return ret
+}
+
+func (s *Server) paramItems(name string) []CompletionItem {
+	cnt := s.VM.GetContract(name)
+	if cnt == nil {
+		return nil
+	}
+	ret := make([]CompletionItem, 0, len(cnt.Params))
+	for _, par := range sortedParams(cnt) {
+		ret = append(ret, CompletionItem{Label: par, Kind: kindField,
+			Detail: compiler.Type2Str(uint32(cnt.Params[par].Type)), InsertText: par + `: `})
+	}
+	return ret
+}
+
+func (s *Server) funcItems(doc *document) []CompletionItem {
+	names := make(map[string]bool)
+	for _, nFunc := range doc.Funcs {
+		names[nFunc.Name] = true
+	}
+	for _, eFunc := range rt.StdLib {
+		names[eFunc.Name] = true
+	}
+	if s.VM.Custom != nil {
+		for _, fItem := range s.VM.Custom.Funcs {
+			names[fItem.Name] = true
+		}
+	}
+	ret := make([]CompletionItem, 0, len(names))
+	for name := range names {
+		ret = append(ret, CompletionItem{Label: name, Kind: kindFunction,
+			Detail: strings.Join(s.signatures(doc, name), `; `)})
+	}
+	sort.Slice(ret, func(i, j int) bool {
+		return ret[i].Label < ret[j].Label
+	})
+	return ret
+}
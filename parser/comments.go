@@ -0,0 +1,255 @@
+package parser
+
+import "strings"
+
+// Position is a position in the source
+type Position struct {
+	Line   int
+	Column int
+}
+
+func (p Position) before(pos Position) bool {
+	return p.Line < pos.Line || (p.Line == pos.Line && p.Column < pos.Column)
+}
+
+// Comment is a comment in the source. The comments are attached to the nodes as trivia.
+// The leading comments are placed before the node. The trailing comments follow the node:
+// the comment on the last line of the node is placed at the end of this line, other
+// trailing comments are placed on the next lines. The inline comment inside the expression
+// is placed before the operand which follows it.
+type Comment struct {
+	Text     string
+	Line     int
+	Column   int
+	Trailing bool
+	Inline   bool
+}
+
+func (c *Comment) pos() Position {
+	return Position{Line: c.Line, Column: c.Column}
+}
+
+func lead(node *Node, c *Comment) {
+	node.Comments = append(node.Comments, c)
+}
+
+func trail(node *Node, c *Comment) {
+	c.Trailing = true
+	node.Comments = append(node.Comments, c)
+}
+
+// attachFile distributes the comments between the contracts of the file. The comments
+// after the last line of the contract belong to the next contract.
+func attachFile(roots []*Node, comments []*Comment, data map[*Node][]Position) {
+	for i, root := range roots {
+		var count int
+		for count < len(comments) && (i+1 == len(roots) || comments[count].Line <= root.Finish.Line) {
+			count++
+		}
+		attachComments(root, comments[:count], data[root.Value.(*NContract).Block])
+		comments = comments[count:]
+	}
+}
+
+// attachComments attaches the comments to the contract, its data block and statements.
+// data contains the positions of data keyword and the closing brace of data block.
+func attachComments(root *Node, comments []*Comment, data []Position) {
+	if len(comments) == 0 {
+		return
+	}
+	block := root.Value.(*NContract).Block
+	var vars, body []*Comment
+	for _, c := range comments {
+		pos := c.pos()
+		switch {
+		case pos.before(root.Begin):
+			lead(root, c)
+		case !pos.before(root.Finish):
+			trail(root, c)
+		case len(data) > 0 && pos.before(data[0]):
+			// the comments before data block
+			lead(block, c)
+		case len(data) > 0 && pos.before(data[1]):
+			vars = append(vars, c)
+		default:
+			body = append(body, c)
+		}
+	}
+	attachVars(block, vars)
+	attachBlock(block, body)
+}
+
+// attachVars attaches the comments of data block to the types of the groups of variables
+func attachVars(block *Node, comments []*Comment) {
+	var groups []*Node
+	params := block.Value.(*NBlock).Params
+	for i, par := range params {
+		if i == 0 || par.Type != params[i-1].Type {
+			groups = append(groups, par.Type)
+		}
+	}
+	var i int
+	for _, c := range comments {
+		for i < len(groups) && !c.pos().before(groups[i].Begin) {
+			i++
+		}
+		switch {
+		case i > 0 && (i == len(groups) || c.Line == groups[i-1].Begin.Line):
+			trail(groups[i-1], c)
+		case i < len(groups):
+			lead(groups[i], c)
+		default:
+			lead(block, c)
+		}
+	}
+}
+
+// attachBlock distributes the comments between the statements of the block. The comments
+// after the last statement are attached to the block.
+func attachBlock(block *Node, comments []*Comment) {
+	list := block.Value.(*NBlock).Statements
+	for i, stmt := range list {
+		var inner []*Comment
+	loop:
+		for len(comments) > 0 {
+			c := comments[0]
+			switch {
+			case c.pos().before(stmt.Begin):
+				lead(stmt, c)
+			case attachInline(stmt, c):
+			case c.Line < stmt.Finish.Line || inBlock(stmt, c):
+				inner = append(inner, c)
+			case c.Line == stmt.Finish.Line && (i+1 == len(list) || c.pos().before(list[i+1].Begin)):
+				trail(stmt, c)
+			default:
+				break loop
+			}
+			comments = comments[1:]
+		}
+		attachInner(stmt, inner)
+	}
+	for _, c := range comments {
+		trail(block, c)
+	}
+}
+
+// attachInline attaches the comment /* */ inside the expression of the statement to the
+// operand which follows it on the same line. The blocks of the statement are skipped.
+func attachInline(stmt *Node, c *Comment) bool {
+	if !strings.HasPrefix(c.Text, `/*`) || strings.Contains(c.Text, "\n") ||
+		!c.pos().before(stmt.Finish) {
+		return false
+	}
+	var operand *Node
+	Inspect(stmt, func(node *Node) bool {
+		if node == nil || node.Type == TBlock {
+			return false
+		}
+		// the nested operands with the same position are printed after the comment
+		if isOperand(node) && node.Begin.Line == c.Line && c.pos().before(node.Begin) &&
+			(operand == nil || !operand.Begin.before(node.Begin)) {
+			operand = node
+		}
+		switch v := node.Value.(type) {
+		case *NCallFunc:
+			return len(v.Interp) == 0
+		case *NObject, *NObjList, *NObjArr:
+			return false
+		}
+		return true
+	})
+	if operand == nil {
+		return false
+	}
+	c.Inline = true
+	lead(operand, c)
+	return true
+}
+
+// isOperand returns true if the node is printed as the expression
+func isOperand(node *Node) bool {
+	switch node.Type {
+	case TValue, TGetVar, TSetVar, TEnv, TGetIndex, TSetIndex, TBinary, TUnary, TQuestion,
+		TCallFunc, TCallContract, TArray, TSlice, TField, TStructValue, TMap, TObject:
+		return true
+	}
+	return false
+}
+
+// inBlock returns true if the comment is inside one of the blocks of the statement
+func inBlock(stmt *Node, c *Comment) bool {
+	for _, block := range childBlocks(stmt) {
+		if !c.pos().before(block.Begin) && c.pos().before(block.Finish) {
+			return true
+		}
+	}
+	return false
+}
+
+// attachInner attaches the comments inside the statement to the statements of its blocks.
+// If the statement doesn't have blocks then the comments become leading comments.
+func attachInner(stmt *Node, comments []*Comment) {
+	if len(comments) == 0 {
+		return
+	}
+	blocks := childBlocks(stmt)
+	if len(blocks) == 0 {
+		for _, c := range comments {
+			lead(stmt, c)
+		}
+		return
+	}
+	var start int
+	for i, block := range blocks {
+		end := len(comments)
+		if i+1 < len(blocks) {
+			// the blocks except the first one have the position of the opening brace
+			next := blocks[i+1].Begin
+			for end = start; end < len(comments) && comments[end].pos().before(next); end++ {
+			}
+		}
+		attachBlock(block, comments[start:end])
+		start = end
+	}
+}
+
+// childBlocks returns the blocks of the statement
+func childBlocks(stmt *Node) []*Node {
+	var list []*Node
+	switch v := stmt.Value.(type) {
+	case *NIf:
+		list = append(list, v.IfBody)
+		if v.ElifBody != nil {
+			for _, item := range v.ElifBody.Value.(*NElif).List {
+				list = append(list, item.Body)
+			}
+		}
+		list = append(list, v.ElseBody)
+	case *NWhile:
+		list = append(list, v.Body)
+	case *NFor:
+		list = append(list, v.Body)
+	case *NForInt:
+		list = append(list, v.Body)
+	case *NFunc:
+		list = append(list, v.Body)
+	case *NSection:
+		list = append(list, v.Body)
+	case *NTry:
+		list = append(list, v.Body, v.Catch)
+	case *NSwitch:
+		if v.Case != nil {
+			for _, item := range v.Case.Value.(*NCase).List {
+				list = append(list, item.Body)
+			}
+		}
+		list = append(list, v.Default)
+	}
+	ret := list[:0]
+	for _, block := range list {
+		if block != nil {
+			ret = append(ret, block)
+		}
+	}
+	return ret
+}
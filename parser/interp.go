@@ -0,0 +1,163 @@
+package parser
+
+import (
+	"strconv"
+	"strings"
+)
+
+const (
+	errInterpClose = `${ is not closed in the string`
+	errInterpExpr  = `${} must contain one expression`
+)
+
+// interpPrefix is the source before the inserted expression when it is parsed.
+// The expression starts at the second line after `return `.
+const interpPrefix = "contract interp {\r\nreturn "
+
+// newInterp creates the call of Sprintf for the string literal with ${expr} insertions, every
+// insertion is formatted with %v. raw is the source of the literal with the quotes, \${ is
+// used for ${ in the text. The literal without insertions becomes the string value.
+func newInterp(raw string, pos, end Position, l yyLexer) *Node {
+	var (
+		format, text string
+		pars         []*Node
+	)
+	line, column := pos.Line, pos.Column+1
+	advance := func(s string) {
+		for _, ch := range []byte(s) {
+			if ch == '\n' {
+				line++
+				column = 1
+			} else {
+				column++
+			}
+		}
+	}
+	body := raw[1 : len(raw)-1]
+	for i := 0; i < len(body); {
+		switch {
+		case strings.HasPrefix(body[i:], `\$`):
+			text += `$`
+			advance(body[i : i+2])
+			i += 2
+		case body[i] == '\\' && i+1 < len(body):
+			text += body[i : i+2]
+			advance(body[i : i+2])
+			i += 2
+		case strings.HasPrefix(body[i:], `${`):
+			stop := closeBrace(body, i+2)
+			if stop < 0 {
+				l.(*lexer).errorAt(line, column, errInterpClose)
+				return newValue(``, l)
+			}
+			format += unquote(text, l) + `%v`
+			text = ``
+			advance(body[i : i+2])
+			expr := parseInsertion(body[i+2:stop], line, column, l)
+			if expr == nil {
+				return newValue(``, l)
+			}
+			pars = append(pars, expr)
+			advance(body[i+2 : stop+1])
+			i = stop + 1
+		default:
+			text += body[i : i+1]
+			advance(body[i : i+1])
+			i++
+		}
+	}
+	if len(pars) == 0 {
+		return setRange(newValue(unquote(text, l), l), pos, end)
+	}
+	format += unquote(text, l)
+	params := setRange(newParam(setRange(newValue(format, l), pos, end), l), pos, end)
+	params.Value.(*NParams).Expr = append(params.Value.(*NParams).Expr, pars...)
+	node := setRange(newCallFunc(`Sprintf`, params, l), pos, end)
+	node.Value.(*NCallFunc).Interp = raw
+	return node
+}
+
+// unquote returns the text of the literal between the insertions, % is doubled for Sprintf
+func unquote(text string, l yyLexer) string {
+	ret, err := strconv.Unquote(`"` + strings.Replace(text, "\n", `\n`, -1) + `"`)
+	if err != nil {
+		l.Error(err.Error())
+	}
+	return strings.Replace(ret, `%`, `%%`, -1)
+}
+
+// closeBrace returns the index of } which closes the insertion or -1. The nested braces
+// and the raw strings are skipped.
+func closeBrace(body string, start int) int {
+	depth := 0
+	for i := start; i < len(body); i++ {
+		switch body[i] {
+		case '`':
+			end := strings.IndexByte(body[i+1:], '`')
+			if end < 0 {
+				return -1
+			}
+			i += end + 1
+		case '{':
+			depth++
+		case '}':
+			if depth == 0 {
+				return i
+			}
+			depth--
+		}
+	}
+	return -1
+}
+
+// parseInsertion parses the expression of ${} which starts at the specified position.
+// The positions of the nodes are moved to the position of the expression in the literal.
+func parseInsertion(src string, line, column int, l yyLexer) *Node {
+	offset := len(`return `) + 1
+	shift := func(lin, col int) (int, int) {
+		if lin == 2 {
+			return line, column + col - offset
+		}
+		return line + lin - 2, col
+	}
+	list, err := parse(``, interpPrefix+src+"\r\n}", false)
+	if err != nil {
+		if perr, ok := err.(*Error); ok && perr.Line >= 2 {
+			// the error after the expression is shown at the closing brace
+			endLine, endCol := 2+strings.Count(src, "\n"), offset+len(src)
+			if off := strings.LastIndexByte(src, '\n'); off >= 0 {
+				endCol = len(src) - off
+			}
+			if perr.Line > endLine || (perr.Line == endLine && perr.Column > endCol) {
+				perr.Line, perr.Column = endLine, endCol
+			}
+			lin, col := shift(perr.Line, perr.Column)
+			l.(*lexer).errorAt(lin, col, perr.Text)
+		} else {
+			l.(*lexer).errorAt(line, column, errInterpExpr)
+		}
+		return nil
+	}
+	stmts := list[0].Value.(*NContract).Block.Value.(*NBlock).Statements
+	if len(stmts) != 1 || stmts[0].Type != TReturn || stmts[0].Value.(*NReturn).Expr == nil {
+		l.(*lexer).errorAt(line, column, errInterpExpr)
+		return nil
+	}
+	expr := stmts[0].Value.(*NReturn).Expr
+	Inspect(expr, func(node *Node) bool {
+		if node == nil {
+			return false
+		}
+		if node.Line > 0 {
+			lin, col := shift(node.Line, int(node.Column))
+			node.Line, node.Column = lin, uint32(col)
+		}
+		for _, pos := range []*Position{&node.Begin, &node.Finish} {
+			if pos.Line > 0 {
+				pos.Line, pos.Column = shift(pos.Line, pos.Column)
+			}
+		}
+		return true
+	})
+	return expr
+}
@@ -0,0 +1,46 @@
+package parser
+
+import (
+	"encoding/json"
+)
+
+// jsonNode is the JSON representation of Node
+type jsonNode struct {
+	Type     string
+	Pos      Position
+	End      Position
+	Result   string      `json:",omitempty"` // the type of the value
+	Value    interface{} `json:",omitempty"`
+	Comments []*Comment  `json:",omitempty"`
+}
+
+// MarshalJSON returns the JSON representation of the node. The types of nodes, values and
+// the operators are represented by their names.
+func (node *Node) MarshalJSON() ([]byte, error) {
+	value := node.Value
+	switch v := value.(type) {
+	case *NBinary:
+		value = struct {
+			Oper        string
+			Left, Right *Node
+		}{operators[v.Oper], v.Left, v.Right}
+	case *NUnary:
+		value = struct {
+			Oper    string
+			Operand *Node
+		}{operators[v.Oper], v.Operand}
+	case *NType:
+		value = struct {
+			Type int64
+			Name string
+		}{v.Type, v.String()}
+	}
+	return json.Marshal(jsonNode{
+		Type:     GetNodeType(node.Type),
+		Pos:      node.Begin,
+		End:      node.Finish,
+		Result:   (&NType{Type: int64(node.Result)}).String(),
+		Value:    value,
+		Comments: node.Comments,
+	})
+}
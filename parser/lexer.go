@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"fmt"
 	"go/token"
+	"strings"
 	"unicode"
 
 	"modernc.org/golex/lex"
@@ -37,8 +38,16 @@ func runeClass(r rune) int {
 type lexer struct {
 	*lex.Lexer
 
-	result interface{}
-	err    error
+	result   []*Node // the parsed contracts
+	err      error
+	comments []*Comment
+	// the positions of data keyword and the end of data block for the blocks of contracts
+	data    map[*Node][]Position
+	started bool
+	// the source can contain several contracts, yyParse is called for each of them
+	multi  bool
+	parsed int        // the count of contracts which have been parsed before this one
+	next   *yySymType // CONTRACT token of the next contract
 }
 
 func (l *lexer) char(r int) lex.Char {
@@ -46,17 +55,87 @@ func (l *lexer) char(r int) lex.Char {
 }
 
 func (l *lexer) Lex(lval *yySymType) int {
+	if l.next != nil {
+		*lval = *l.next
+		l.next = nil
+		return CONTRACT
+	}
 	c := l.scan(lval)
+	// the empty lines and comments before the contract are skipped
+	for !l.started && c.Rune == NEWLINE {
+		c = l.scan(lval)
+	}
+	l.started = true
+	pos := l.FilePosition()
+	lval.p = Position{Line: pos.Line, Column: pos.Column}
+	lval.e = lval.p
+	// the whitespaces after the token are not included
+	for _, ch := range bytes.TrimRight(l.TokenBytes(nil), " \t\r\n") {
+		if ch == '\n' {
+			lval.e.Line++
+			lval.e.Column = 1
+		} else {
+			lval.e.Column++
+		}
+	}
 
 	if c.Rune == lex.RuneEOF {
 		return 0
 	}
+	// the current contract ends before the next one
+	if c.Rune == CONTRACT && l.multi && len(l.result) > l.parsed {
+		next := *lval
+		l.next = &next
+		return 0
+	}
 
 	return int(c.Rune)
 }
 
+// Error is a syntax error with the position in the source
+type Error struct {
+	File   string // the name of the source file, it can be empty
+	Line   int
+	Column int
+	Text   string
+}
+
+func (e *Error) Error() string {
+	if len(e.File) > 0 {
+		return fmt.Sprintf("%s:%d:%d: %s", e.File, e.Line, e.Column, e.Text)
+	}
+	return fmt.Sprintf("%d:%d: %s", e.Line, e.Column, e.Text)
+}
+
 func (l *lexer) Error(err string) {
-	l.err = fmt.Errorf("%s: %s", l.FilePosition(), err)
+	pos := l.FilePosition()
+	l.err = &Error{File: pos.Filename, Line: pos.Line, Column: pos.Column, Text: err}
+}
+
+// errorAt sets the error at the specified position if there is no error yet
+func (l *lexer) errorAt(line, column int, err string) {
+	if l.err == nil {
+		pos := l.FilePosition()
+		l.err = &Error{File: pos.Filename, Line: line, Column: column, Text: err}
+	}
+}
+
+// comment saves the comment which has been scanned
+func (l *lexer) comment() {
+	pos := l.FilePosition()
+	// the comment at the end of the source contains EOF
+	text := l.TokenBytes(func(buf *bytes.Buffer) {
+		for _, c := range l.Token() {
+			if c.Rune != lex.RuneEOF {
+				buf.WriteRune(c.Rune)
+			}
+		}
+	})
+	l.comments = append(l.comments, &Comment{
+		Text:   strings.Replace(strings.TrimRight(string(text), "\r\n"), "\r\n", "\n", -1),
+		Line:   pos.Line,
+		Column: pos.Column,
+	})
 }
 
 func (l *lexer) FilePosition() token.Position {
@@ -73,5 +152,49 @@ func NewLexer(filename string, src string) (*lexer, error) {
 		return nil, err
 	}
 
-	return &lexer{l, nil, nil}, nil
+	return &lexer{Lexer: l, data: make(map[*Node][]Position)}, nil
+}
+
+// Token is a lexical token with its position in the source
+type Token struct {
+	Type   int    // IDENT, CALL, ENV etc. or the character of the unknown token
+	Text   string // the name of the identifier, function, contract or environment variable
+	Line   int
+	Column int // the byte column of the first character
+	End    int // the byte column after the name
+}
+
+// Tokens splits the source into the tokens. The tokens are returned even if there is an error.
+func Tokens(input string) ([]Token, error) {
+	l, err := NewLexer(``, input)
+	if err != nil {
+		return nil, err
+	}
+	var ret []Token
+	for {
+		var lval yySymType
+		c := l.scan(&lval)
+		if c.Rune == lex.RuneEOF {
+			break
+		}
+		pos := l.FilePosition()
+		token := Token{Type: int(c.Rune), Line: pos.Line, Column: pos.Column,
+			End: pos.Column + len(bytes.TrimRight(l.TokenBytes(nil), " \t\r\n"))}
+		if token.End == token.Column {
+			token.End++
+		}
+		switch token.Type {
+		case IDENT, CALL, INDEX:
+			token.Text = lval.s
+			token.End = pos.Column + len(lval.s)
+		case CALLCONTRACT:
+			token.Text, _ = splitVersion(lval.s[1:])
+			token.End = pos.Column + len(lval.s)
+		case ENV:
+			token.Text = lval.s
+			token.End = pos.Column + len(lval.s) + 1
+		}
+		ret = append(ret, token)
+	}
+	return ret, l.err
 }
@@ -19,8 +19,6 @@ yystate0:
 
 	goto yystart1
 
-	goto yystate0 // silence unused label error
-	goto yyAction // silence unused label error
 yyAction:
 	switch yyrule {
 	case 1:
@@ -177,8 +175,53 @@ yyAction:
 		goto yyrule76
 	case 77:
 		goto yyrule77
+	case 78:
+		goto yyrule78
+	case 79:
+		goto yyrule79
+	case 80:
+		goto yyrule80
+	case 81:
+		goto yyrule81
+	case 82:
+		goto yyrule82
+	case 83:
+		goto yyrule83
+	case 84:
+		goto yyrule84
+	case 85:
+		goto yyrule85
+	case 86:
+		goto yyrule86
+	case 87:
+		goto yyrule87
+	case 88:
+		goto yyrule88
+	case 89:
+		goto yyrule89
+	case 90:
+		goto yyrule90
+	case 91:
+		goto yyrule91
+	case 92:
+		goto yyrule92
+	case 93:
+		goto yyrule93
+	case 94:
+		goto yyrule94
+	case 95:
+		goto yyrule95
+	case 96:
+		goto yyrule96
+	case 97:
+		goto yyrule97
+	case 98:
+		goto yyrule98
+	case 99:
+		goto yyrule99
+	case 100:
+		goto yyrule100
 	}
-	goto yystate1 // silence unused label error
 yystate1:
 	c = l.Next()
 yystart1:
@@ -196,85 +239,93 @@ yystart1:
 	case c == '&':
 		goto yystate18
 	case c == '(':
-		goto yystate20
+		goto yystate23
 	case c == ')':
-		goto yystate22
+		goto yystate25
 	case c == '*':
-		goto yystate23
+		goto yystate26
 	case c == '+':
-		goto yystate27
+		goto yystate30
 	case c == ',':
-		goto yystate31
+		goto yystate35
 	case c == '-':
-		goto yystate33
-	case c == '.':
 		goto yystate37
+	case c == '.':
+		goto yystate42
 	case c == '/':
-		goto yystate39
+		goto yystate44
 	case c == '0':
-		goto yystate48
+		goto yystate53
 	case c == ':':
-		goto yystate54
+		goto yystate59
 	case c == ';':
-		goto yystate55
+		goto yystate60
 	case c == '<':
-		goto yystate56
-	case c == '=':
 		goto yystate61
+	case c == '=':
+		goto yystate70
 	case c == '>':
-		goto yystate64
+		goto yystate73
 	case c == '?':
-		goto yystate69
+		goto yystate82
 	case c == '@':
-		goto yystate70
+		goto yystate83
 	case c == '[':
-		goto yystate78
+		goto yystate100
 	case c == '\n':
 		goto yystate3
 	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate2
 	case c == ']':
-		goto yystate80
+		goto yystate102
+	case c == '^':
+		goto yystate103
+	case c == '_' || c == 'g' || c == 'j' || c == 'k' || c == 'n' || c == 'p' || c == 'q' || c == 'u' || c == 'v' || c >= 'x' && c <= 'z' || c == '\u0080':
+		goto yystate107
 	case c == '`':
-		goto yystate81
+		goto yystate108
 	case c == 'a':
-		goto yystate83
+		goto yystate110
 	case c == 'b':
-		goto yystate86
+		goto yystate118
 	case c == 'c':
-		goto yystate98
+		goto yystate130
 	case c == 'd':
-		goto yystate113
+		goto yystate155
 	case c == 'e':
-		goto yystate123
+		goto yystate165
 	case c == 'f':
-		goto yystate129
+		goto yystate171
 	case c == 'h':
-		goto yystate146
+		goto yystate188
 	case c == 'i':
-		goto yystate152
+		goto yystate194
+	case c == 'l':
+		goto yystate203
 	case c == 'm':
-		goto yystate156
+		goto yystate210
 	case c == 'o':
-		goto yystate163
+		goto yystate217
 	case c == 'r':
-		goto yystate166
+		goto yystate220
 	case c == 's':
-		goto yystate174
+		goto yystate228
 	case c == 't':
-		goto yystate182
+		goto yystate239
 	case c == 'w':
-		goto yystate186
+		goto yystate247
 	case c == '{':
-		goto yystate191
+		goto yystate252
 	case c == '|':
-		goto yystate193
+		goto yystate254
 	case c == '}':
-		goto yystate195
+		goto yystate259
+	case c == '~':
+		goto yystate260
 	case c >= '1' && c <= '9':
-		goto yystate51
-	case c >= 'A' && c <= 'Z' || c == '_' || c == 'g' || c >= 'j' && c <= 'l' || c == 'n' || c == 'p' || c == 'q' || c == 'u' || c == 'v' || c >= 'x' && c <= 'z' || c == '\u0080':
-		goto yystate75
+		goto yystate56
+	case c >= 'A' && c <= 'Z':
+		goto yystate91
 	}
 
 yystate2:
@@ -290,11 +341,11 @@ yystate2:
 
 yystate3:
 	c = l.Next()
-	yyrule = 10
+	yyrule = 15
 	l.Mark()
 	switch {
 	default:
-		goto yyrule10
+		goto yyrule15
 	case c == ')':
 		goto yystate5
 	case c == '\t' || c == ' ':
@@ -314,28 +365,28 @@ yystate4:
 
 yystate5:
 	c = l.Next()
-	yyrule = 18
+	yyrule = 23
 	l.Mark()
-	goto yyrule18
+	goto yyrule23
 
 yystate6:
 	c = l.Next()
-	yyrule = 34
+	yyrule = 47
 	l.Mark()
 	switch {
 	default:
-		goto yyrule34
+		goto yyrule47
 	case c == '=':
 		goto yystate7
 	}
 
 yystate7:
 	c = l.Next()
-	yyrule = 33
+	yyrule = 46
 	l.Mark()
 	switch {
 	default:
-		goto yyrule33
+		goto yyrule46
 	case c == '\n':
 		goto yystate8
 	case c == '\t' || c == '\r' || c == ' ':
@@ -344,9 +395,9 @@ yystate7:
 
 yystate8:
 	c = l.Next()
-	yyrule = 33
+	yyrule = 46
 	l.Mark()
-	goto yyrule33
+	goto yyrule46
 
 yystate9:
 	c = l.Next()
@@ -363,9 +414,9 @@ yystate9:
 
 yystate10:
 	c = l.Next()
-	yyrule = 73
+	yyrule = 94
 	l.Mark()
-	goto yyrule73
+	goto yyrule94
 
 yystate11:
 	c = l.Next()
@@ -387,22 +438,22 @@ yystate12:
 
 yystate13:
 	c = l.Next()
-	yyrule = 72
+	yyrule = 93
 	l.Mark()
 	switch {
 	default:
-		goto yyrule72
+		goto yyrule93
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
 		goto yystate13
 	}
 
 yystate14:
 	c = l.Next()
-	yyrule = 31
+	yyrule = 38
 	l.Mark()
 	switch {
 	default:
-		goto yyrule31
+		goto yyrule38
 	case c == '=':
 		goto yystate17
 	case c == '\n':
@@ -413,11 +464,11 @@ yystate14:
 
 yystate15:
 	c = l.Next()
-	yyrule = 31
+	yyrule = 38
 	l.Mark()
 	switch {
 	default:
-		goto yyrule31
+		goto yyrule38
 	case c == '\n':
 		goto yystate16
 	case c == '\t' || c == '\r' || c == ' ':
@@ -426,9 +477,9 @@ yystate15:
 
 yystate16:
 	c = l.Next()
-	yyrule = 31
+	yyrule = 38
 	l.Mark()
-	goto yyrule31
+	goto yyrule38
 
 yystate17:
 	c = l.Next()
@@ -438,131 +489,141 @@ yystate17:
 
 yystate18:
 	c = l.Next()
+	yyrule = 39
+	l.Mark()
 	switch {
 	default:
-		goto yyabort
+		goto yyrule39
 	case c == '&':
+		goto yystate21
+	case c == '=':
+		goto yystate22
+	case c == '\n':
+		goto yystate20
+	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate19
 	}
 
 yystate19:
 	c = l.Next()
-	yyrule = 25
-	l.Mark()
-	goto yyrule25
-
-yystate20:
-	c = l.Next()
-	yyrule = 17
+	yyrule = 39
 	l.Mark()
 	switch {
 	default:
-		goto yyrule17
+		goto yyrule39
 	case c == '\n':
-		goto yystate21
-	case c == '\t' || c == ' ':
 		goto yystate20
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate19
 	}
 
+yystate20:
+	c = l.Next()
+	yyrule = 39
+	l.Mark()
+	goto yyrule39
+
 yystate21:
 	c = l.Next()
-	yyrule = 17
+	yyrule = 30
 	l.Mark()
-	goto yyrule17
+	goto yyrule30
 
 yystate22:
 	c = l.Next()
-	yyrule = 19
+	yyrule = 9
 	l.Mark()
-	goto yyrule19
+	goto yyrule9
 
 yystate23:
 	c = l.Next()
-	yyrule = 29
+	yyrule = 22
 	l.Mark()
 	switch {
 	default:
-		goto yyrule29
-	case c == '=':
-		goto yystate26
+		goto yyrule22
 	case c == '\n':
-		goto yystate25
-	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate24
+	case c == '\t' || c == ' ':
+		goto yystate23
 	}
 
 yystate24:
 	c = l.Next()
-	yyrule = 29
+	yyrule = 22
 	l.Mark()
-	switch {
-	default:
-		goto yyrule29
-	case c == '\n':
-		goto yystate25
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate24
-	}
+	goto yyrule22
 
 yystate25:
 	c = l.Next()
-	yyrule = 29
+	yyrule = 24
 	l.Mark()
-	goto yyrule29
+	goto yyrule24
 
 yystate26:
 	c = l.Next()
-	yyrule = 6
-	l.Mark()
-	goto yyrule6
-
-yystate27:
-	c = l.Next()
-	yyrule = 27
+	yyrule = 36
 	l.Mark()
 	switch {
 	default:
-		goto yyrule27
+		goto yyrule36
 	case c == '=':
-		goto yystate30
-	case c == '\n':
 		goto yystate29
-	case c == '\t' || c == '\r' || c == ' ':
+	case c == '\n':
 		goto yystate28
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate27
 	}
 
-yystate28:
+yystate27:
 	c = l.Next()
-	yyrule = 27
+	yyrule = 36
 	l.Mark()
 	switch {
 	default:
-		goto yyrule27
+		goto yyrule36
 	case c == '\n':
-		goto yystate29
-	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate28
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate27
 	}
 
+yystate28:
+	c = l.Next()
+	yyrule = 36
+	l.Mark()
+	goto yyrule36
+
 yystate29:
 	c = l.Next()
-	yyrule = 27
+	yyrule = 6
 	l.Mark()
-	goto yyrule27
+	goto yyrule6
 
 yystate30:
 	c = l.Next()
-	yyrule = 4
+	yyrule = 34
 	l.Mark()
-	goto yyrule4
+	switch {
+	default:
+		goto yyrule34
+	case c == '+':
+		goto yystate33
+	case c == '=':
+		goto yystate34
+	case c == '\n':
+		goto yystate32
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate31
+	}
 
 yystate31:
 	c = l.Next()
-	yyrule = 12
+	yyrule = 34
 	l.Mark()
 	switch {
 	default:
-		goto yyrule12
+		goto yyrule34
 	case c == '\n':
 		goto yystate32
 	case c == '\t' || c == '\r' || c == ' ':
@@ -571,316 +632,303 @@ yystate31:
 
 yystate32:
 	c = l.Next()
-	yyrule = 12
+	yyrule = 34
 	l.Mark()
-	goto yyrule12
+	goto yyrule34
 
 yystate33:
 	c = l.Next()
-	yyrule = 28
+	yyrule = 32
 	l.Mark()
-	switch {
-	default:
-		goto yyrule28
-	case c == '=':
-		goto yystate36
-	case c == '\n':
-		goto yystate35
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate34
-	}
+	goto yyrule32
 
 yystate34:
 	c = l.Next()
-	yyrule = 28
+	yyrule = 4
+	l.Mark()
+	goto yyrule4
+
+yystate35:
+	c = l.Next()
+	yyrule = 17
 	l.Mark()
 	switch {
 	default:
-		goto yyrule28
+		goto yyrule17
 	case c == '\n':
-		goto yystate35
+		goto yystate36
 	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate34
+		goto yystate35
 	}
 
-yystate35:
+yystate36:
 	c = l.Next()
-	yyrule = 28
+	yyrule = 17
 	l.Mark()
-	goto yyrule28
+	goto yyrule17
 
-yystate36:
+yystate37:
+	c = l.Next()
+	yyrule = 35
+	l.Mark()
+	switch {
+	default:
+		goto yyrule35
+	case c == '-':
+		goto yystate40
+	case c == '=':
+		goto yystate41
+	case c == '\n':
+		goto yystate39
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate38
+	}
+
+yystate38:
+	c = l.Next()
+	yyrule = 35
+	l.Mark()
+	switch {
+	default:
+		goto yyrule35
+	case c == '\n':
+		goto yystate39
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate38
+	}
+
+yystate39:
+	c = l.Next()
+	yyrule = 35
+	l.Mark()
+	goto yyrule35
+
+yystate40:
+	c = l.Next()
+	yyrule = 33
+	l.Mark()
+	goto yyrule33
+
+yystate41:
 	c = l.Next()
 	yyrule = 5
 	l.Mark()
 	goto yyrule5
 
-yystate37:
+yystate42:
 	c = l.Next()
-	yyrule = 15
+	yyrule = 20
 	l.Mark()
 	switch {
 	default:
-		goto yyrule15
+		goto yyrule20
 	case c == '.':
-		goto yystate38
+		goto yystate43
 	}
 
-yystate38:
+yystate43:
 	c = l.Next()
-	yyrule = 14
+	yyrule = 19
 	l.Mark()
-	goto yyrule14
+	goto yyrule19
 
-yystate39:
+yystate44:
 	c = l.Next()
-	yyrule = 30
+	yyrule = 37
 	l.Mark()
 	switch {
 	default:
-		goto yyrule30
+		goto yyrule37
 	case c == '*':
-		goto yystate42
+		goto yystate47
 	case c == '/':
-		goto yystate45
+		goto yystate50
 	case c == '=':
-		goto yystate47
+		goto yystate52
 	case c == '\n':
-		goto yystate41
+		goto yystate46
 	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate40
+		goto yystate45
 	}
 
-yystate40:
+yystate45:
 	c = l.Next()
-	yyrule = 30
+	yyrule = 37
 	l.Mark()
 	switch {
 	default:
-		goto yyrule30
+		goto yyrule37
 	case c == '\n':
-		goto yystate41
+		goto yystate46
 	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate40
+		goto yystate45
 	}
 
-yystate41:
+yystate46:
 	c = l.Next()
-	yyrule = 30
+	yyrule = 37
 	l.Mark()
-	goto yyrule30
+	goto yyrule37
 
-yystate42:
+yystate47:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '*':
-		goto yystate43
+		goto yystate48
 	case c >= '\x01' && c <= ')' || c >= '+' && c <= 'ÿ':
-		goto yystate42
+		goto yystate47
 	}
 
-yystate43:
+yystate48:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c == '*':
-		goto yystate43
+		goto yystate48
 	case c == '/':
-		goto yystate44
+		goto yystate49
 	case c >= '\x01' && c <= ')' || c >= '+' && c <= '.' || c >= '0' && c <= 'ÿ':
-		goto yystate42
+		goto yystate47
 	}
 
-yystate44:
+yystate49:
 	c = l.Next()
 	yyrule = 2
 	l.Mark()
-	switch {
-	default:
-		goto yyrule2
-	case c == '*':
-		goto yystate43
-	case c >= '\x01' && c <= ')' || c >= '+' && c <= 'ÿ':
-		goto yystate42
-	}
+	goto yyrule2
 
-yystate45:
+yystate50:
 	c = l.Next()
+	yyrule = 3
+	l.Mark()
 	switch {
 	default:
-		goto yyabort
+		goto yyrule3
 	case c == '\n':
-		goto yystate46
+		goto yystate51
 	case c >= '\x01' && c <= '\t' || c >= '\v' && c <= 'ÿ':
-		goto yystate45
+		goto yystate50
 	}
 
-yystate46:
+yystate51:
 	c = l.Next()
 	yyrule = 3
 	l.Mark()
 	goto yyrule3
 
-yystate47:
+yystate52:
 	c = l.Next()
 	yyrule = 7
 	l.Mark()
 	goto yyrule7
 
-yystate48:
+yystate53:
 	c = l.Next()
-	yyrule = 70
+	yyrule = 91
 	l.Mark()
 	switch {
 	default:
-		goto yyrule70
+		goto yyrule91
 	case c == '.':
-		goto yystate49
+		goto yystate54
 	case c == 'X' || c == 'x':
-		goto yystate52
+		goto yystate57
 	case c >= '0' && c <= '9':
-		goto yystate51
+		goto yystate56
 	}
 
-yystate49:
+yystate54:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c >= '0' && c <= '9':
-		goto yystate50
+		goto yystate55
 	}
 
-yystate50:
+yystate55:
 	c = l.Next()
-	yyrule = 68
+	yyrule = 89
 	l.Mark()
 	switch {
 	default:
-		goto yyrule68
+		goto yyrule89
 	case c >= '0' && c <= '9':
-		goto yystate50
+		goto yystate55
 	}
 
-yystate51:
+yystate56:
 	c = l.Next()
-	yyrule = 70
+	yyrule = 91
 	l.Mark()
 	switch {
 	default:
-		goto yyrule70
+		goto yyrule91
 	case c == '.':
-		goto yystate49
+		goto yystate54
 	case c >= '0' && c <= '9':
-		goto yystate51
+		goto yystate56
 	}
 
-yystate52:
+yystate57:
 	c = l.Next()
 	switch {
 	default:
 		goto yyabort
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'a' && c <= 'f':
-		goto yystate53
-	}
-
-yystate53:
-	c = l.Next()
-	yyrule = 69
-	l.Mark()
-	switch {
-	default:
-		goto yyrule69
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'a' && c <= 'f':
-		goto yystate53
-	}
-
-yystate54:
-	c = l.Next()
-	yyrule = 13
-	l.Mark()
-	goto yyrule13
-
-yystate55:
-	c = l.Next()
-	yyrule = 11
-	l.Mark()
-	goto yyrule11
-
-yystate56:
-	c = l.Next()
-	yyrule = 37
-	l.Mark()
-	switch {
-	default:
-		goto yyrule37
-	case c == '=':
-		goto yystate59
-	case c == '\n':
 		goto yystate58
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate57
 	}
 
-yystate57:
+yystate58:
 	c = l.Next()
-	yyrule = 37
+	yyrule = 90
 	l.Mark()
 	switch {
 	default:
-		goto yyrule37
-	case c == '\n':
+		goto yyrule90
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'F' || c >= 'a' && c <= 'f':
 		goto yystate58
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate57
 	}
 
-yystate58:
-	c = l.Next()
-	yyrule = 37
-	l.Mark()
-	goto yyrule37
-
 yystate59:
 	c = l.Next()
-	yyrule = 35
+	yyrule = 18
 	l.Mark()
-	switch {
-	default:
-		goto yyrule35
-	case c == '\n':
-		goto yystate60
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate59
-	}
+	goto yyrule18
 
 yystate60:
 	c = l.Next()
-	yyrule = 35
+	yyrule = 16
 	l.Mark()
-	goto yyrule35
+	goto yyrule16
 
 yystate61:
 	c = l.Next()
-	yyrule = 9
+	yyrule = 50
 	l.Mark()
 	switch {
 	default:
-		goto yyrule9
+		goto yyrule50
+	case c == '<':
+		goto yystate64
 	case c == '=':
+		goto yystate68
+	case c == '\n':
+		goto yystate63
+	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate62
 	}
 
 yystate62:
 	c = l.Next()
-	yyrule = 32
+	yyrule = 50
 	l.Mark()
 	switch {
 	default:
-		goto yyrule32
+		goto yyrule50
 	case c == '\n':
 		goto yystate63
 	case c == '\t' || c == '\r' || c == ' ':
@@ -889,17 +937,17 @@ yystate62:
 
 yystate63:
 	c = l.Next()
-	yyrule = 32
+	yyrule = 50
 	l.Mark()
-	goto yyrule32
+	goto yyrule50
 
 yystate64:
 	c = l.Next()
-	yyrule = 38
+	yyrule = 42
 	l.Mark()
 	switch {
 	default:
-		goto yyrule38
+		goto yyrule42
 	case c == '=':
 		goto yystate67
 	case c == '\n':
@@ -910,11 +958,11 @@ yystate64:
 
 yystate65:
 	c = l.Next()
-	yyrule = 38
+	yyrule = 42
 	l.Mark()
 	switch {
 	default:
-		goto yyrule38
+		goto yyrule42
 	case c == '\n':
 		goto yystate66
 	case c == '\t' || c == '\r' || c == ' ':
@@ -923,456 +971,1348 @@ yystate65:
 
 yystate66:
 	c = l.Next()
-	yyrule = 38
+	yyrule = 42
 	l.Mark()
-	goto yyrule38
+	goto yyrule42
 
 yystate67:
 	c = l.Next()
-	yyrule = 36
+	yyrule = 12
 	l.Mark()
-	switch {
-	default:
-		goto yyrule36
-	case c == '\n':
-		goto yystate68
-	case c == '\t' || c == '\r' || c == ' ':
-		goto yystate67
-	}
+	goto yyrule12
 
 yystate68:
 	c = l.Next()
-	yyrule = 36
+	yyrule = 48
 	l.Mark()
-	goto yyrule36
+	switch {
+	default:
+		goto yyrule48
+	case c == '\n':
+		goto yystate69
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate68
+	}
 
 yystate69:
 	c = l.Next()
-	yyrule = 16
+	yyrule = 48
 	l.Mark()
-	goto yyrule16
+	goto yyrule48
 
 yystate70:
 	c = l.Next()
+	yyrule = 14
+	l.Mark()
 	switch {
 	default:
-		goto yyabort
-	case c == '{':
-		goto yystate73
-	case c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080':
+		goto yyrule14
+	case c == '=':
 		goto yystate71
 	}
 
 yystate71:
 	c = l.Next()
+	yyrule = 45
+	l.Mark()
 	switch {
 	default:
-		goto yyabort
-	case c == '(':
+		goto yyrule45
+	case c == '\n':
 		goto yystate72
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate71
 	}
 
 yystate72:
 	c = l.Next()
-	yyrule = 76
+	yyrule = 45
 	l.Mark()
-	goto yyrule76
+	goto yyrule45
 
 yystate73:
 	c = l.Next()
-	yyrule = 20
+	yyrule = 51
 	l.Mark()
 	switch {
 	default:
-		goto yyrule20
+		goto yyrule51
+	case c == '=':
+		goto yystate76
+	case c == '>':
+		goto yystate78
 	case c == '\n':
+		goto yystate75
+	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate74
-	case c == '\t' || c == ' ':
-		goto yystate73
 	}
 
 yystate74:
 	c = l.Next()
-	yyrule = 20
-	l.Mark()
-	goto yyrule20
-
-yystate75:
-	c = l.Next()
-	yyrule = 71
+	yyrule = 51
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yyrule51
+	case c == '\n':
 		goto yystate75
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate74
 	}
 
+yystate75:
+	c = l.Next()
+	yyrule = 51
+	l.Mark()
+	goto yyrule51
+
 yystate76:
 	c = l.Next()
-	yyrule = 75
+	yyrule = 49
 	l.Mark()
-	goto yyrule75
+	switch {
+	default:
+		goto yyrule49
+	case c == '\n':
+		goto yystate77
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate76
+	}
 
 yystate77:
 	c = l.Next()
-	yyrule = 77
+	yyrule = 49
 	l.Mark()
-	goto yyrule77
+	goto yyrule49
 
 yystate78:
 	c = l.Next()
-	yyrule = 23
+	yyrule = 43
 	l.Mark()
 	switch {
 	default:
-		goto yyrule23
+		goto yyrule43
+	case c == '=':
+		goto yystate81
 	case c == '\n':
+		goto yystate80
+	case c == '\t' || c == '\r' || c == ' ':
 		goto yystate79
-	case c == '\t' || c == ' ':
-		goto yystate78
 	}
 
 yystate79:
 	c = l.Next()
-	yyrule = 23
+	yyrule = 43
 	l.Mark()
-	goto yyrule23
+	switch {
+	default:
+		goto yyrule43
+	case c == '\n':
+		goto yystate80
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate79
+	}
 
 yystate80:
 	c = l.Next()
-	yyrule = 24
+	yyrule = 43
 	l.Mark()
-	goto yyrule24
+	goto yyrule43
 
 yystate81:
 	c = l.Next()
-	switch {
-	default:
-		goto yyabort
-	case c == '`':
-		goto yystate82
-	case c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ':
-		goto yystate81
-	}
+	yyrule = 13
+	l.Mark()
+	goto yyrule13
 
 yystate82:
 	c = l.Next()
-	yyrule = 74
+	yyrule = 21
 	l.Mark()
-	goto yyrule74
+	goto yyrule21
 
 yystate83:
 	c = l.Next()
-	yyrule = 71
-	l.Mark()
 	switch {
 	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'r':
+		goto yyabort
+	case c == '{':
+		goto yystate89
+	case c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080':
 		goto yystate84
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
 	}
 
 yystate84:
 	c = l.Next()
-	yyrule = 71
-	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyabort
 	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'r':
 		goto yystate85
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+	case c == '.':
+		goto yystate86
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate84
 	}
 
 yystate85:
 	c = l.Next()
-	yyrule = 61
+	yyrule = 97
 	l.Mark()
-	switch {
-	default:
-		goto yyrule61
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
-	}
+	goto yyrule97
 
 yystate86:
 	c = l.Next()
-	yyrule = 71
-	l.Mark()
 	switch {
 	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'o':
+		goto yyabort
+	case c == 'v':
 		goto yystate87
-	case c == 'r':
-		goto yystate90
-	case c == 'y':
-		goto yystate94
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
 	}
 
 yystate87:
 	c = l.Next()
-	yyrule = 71
-	l.Mark()
 	switch {
 	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'o':
+		goto yyabort
+	case c >= '0' && c <= '9':
 		goto yystate88
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
 	}
 
 yystate88:
 	c = l.Next()
-	yyrule = 71
-	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyabort
 	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'l':
-		goto yystate89
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate85
+	case c >= '0' && c <= '9':
+		goto yystate88
 	}
 
 yystate89:
 	c = l.Next()
-	yyrule = 57
+	yyrule = 25
 	l.Mark()
 	switch {
 	default:
-		goto yyrule57
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yyrule25
+	case c == '\n':
+		goto yystate90
+	case c == '\t' || c == ' ':
+		goto yystate89
 	}
 
 yystate90:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 25
 	l.Mark()
-	switch {
-	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate91
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
-	}
+	goto yyrule25
 
 yystate91:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
-	case c == 'a':
 		goto yystate92
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == '{':
+		goto yystate98
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate91
 	}
 
 yystate92:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 96
 	l.Mark()
+	goto yyrule96
+
+yystate93:
+	c = l.Next()
 	switch {
 	default:
-		goto yyrule71
-	case c == '(':
-		goto yystate76
-	case c == '[':
-		goto yystate77
+		goto yyabort
+	case c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080':
+		goto yystate94
+	}
+
+yystate94:
+	c = l.Next()
+	yyrule = 98
+	l.Mark()
+	switch {
+	default:
+		goto yyrule98
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate95
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate94
+	}
+
+yystate95:
+	c = l.Next()
+	switch {
+	default:
+		goto yyabort
+	case c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080':
+		goto yystate96
+	}
+
+yystate96:
+	c = l.Next()
+	yyrule = 98
+	l.Mark()
+	switch {
+	default:
+		goto yyrule98
+	case c == '.':
+		goto yystate95
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate96
+	}
+
+yystate97:
+	c = l.Next()
+	yyrule = 100
+	l.Mark()
+	goto yyrule100
+
+yystate98:
+	c = l.Next()
+	yyrule = 99
+	l.Mark()
+	switch {
+	default:
+		goto yyrule99
+	case c == '\n':
+		goto yystate99
+	case c == '\t' || c == ' ':
+		goto yystate98
+	}
+
+yystate99:
+	c = l.Next()
+	yyrule = 99
+	l.Mark()
+	goto yyrule99
+
+yystate100:
+	c = l.Next()
+	yyrule = 28
+	l.Mark()
+	switch {
+	default:
+		goto yyrule28
+	case c == '\n':
+		goto yystate101
+	case c == '\t' || c == ' ':
+		goto yystate100
+	}
+
+yystate101:
+	c = l.Next()
+	yyrule = 28
+	l.Mark()
+	goto yyrule28
+
+yystate102:
+	c = l.Next()
+	yyrule = 29
+	l.Mark()
+	goto yyrule29
+
+yystate103:
+	c = l.Next()
+	yyrule = 41
+	l.Mark()
+	switch {
+	default:
+		goto yyrule41
+	case c == '=':
+		goto yystate106
+	case c == '\n':
+		goto yystate105
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate104
+	}
+
+yystate104:
+	c = l.Next()
+	yyrule = 41
+	l.Mark()
+	switch {
+	default:
+		goto yyrule41
+	case c == '\n':
+		goto yystate105
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate104
+	}
+
+yystate105:
+	c = l.Next()
+	yyrule = 41
+	l.Mark()
+	goto yyrule41
+
+yystate106:
+	c = l.Next()
+	yyrule = 11
+	l.Mark()
+	goto yyrule11
+
+yystate107:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate108:
+	c = l.Next()
+	switch {
+	default:
+		goto yyabort
+	case c == '`':
+		goto yystate109
+	case c >= '\x01' && c <= '_' || c >= 'a' && c <= 'ÿ':
+		goto yystate108
+	}
+
+yystate109:
+	c = l.Next()
+	yyrule = 95
+	l.Mark()
+	goto yyrule95
+
+yystate110:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'c':
+		goto yystate111
+	case c == 'r':
+		goto yystate116
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate111:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 't':
+		goto yystate112
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate112:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'i':
+		goto yystate113
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate113:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'o':
+		goto yystate114
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate114:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'n':
+		goto yystate115
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate115:
+	c = l.Next()
+	yyrule = 59
+	l.Mark()
+	switch {
+	default:
+		goto yyrule59
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate116:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'r':
+		goto yystate117
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate117:
+	c = l.Next()
+	yyrule = 82
+	l.Mark()
+	switch {
+	default:
+		goto yyrule82
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate118:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'o':
+		goto yystate119
+	case c == 'r':
+		goto yystate122
+	case c == 'y':
+		goto yystate126
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c == 'p' || c == 'q' || c >= 's' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate119:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'o':
+		goto yystate120
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate120:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'l':
+		goto yystate121
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate121:
+	c = l.Next()
+	yyrule = 78
+	l.Mark()
+	switch {
+	default:
+		goto yyrule78
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate122:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'e':
+		goto yystate123
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate123:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'a':
+		goto yystate124
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate124:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
 	case c == 'k':
+		goto yystate125
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate125:
+	c = l.Next()
+	yyrule = 52
+	l.Mark()
+	switch {
+	default:
+		goto yyrule52
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate126:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 't':
+		goto yystate127
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate127:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'e':
+		goto yystate128
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate128:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 's':
+		goto yystate129
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate129:
+	c = l.Next()
+	yyrule = 87
+	l.Mark()
+	switch {
+	default:
+		goto yyrule87
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate130:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'a':
+		goto yystate131
+	case c == 'o':
+		goto yystate137
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate131:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 's':
+		goto yystate132
+	case c == 't':
+		goto yystate134
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate132:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'e':
+		goto yystate133
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate133:
+	c = l.Next()
+	yyrule = 75
+	l.Mark()
+	switch {
+	default:
+		goto yyrule75
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate134:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'c':
+		goto yystate135
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate135:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'h':
+		goto yystate136
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate136:
+	c = l.Next()
+	yyrule = 61
+	l.Mark()
+	switch {
+	default:
+		goto yyrule61
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate137:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'n':
+		goto yystate138
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate138:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'd':
+		goto yystate139
+	case c == 't':
+		goto yystate146
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate139:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'i':
+		goto yystate140
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate140:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 't':
+		goto yystate141
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate141:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'i':
+		goto yystate142
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate142:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'o':
+		goto yystate143
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate143:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'n':
+		goto yystate144
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate144:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 's':
+		goto yystate145
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate145:
+	c = l.Next()
+	yyrule = 58
+	l.Mark()
+	switch {
+	default:
+		goto yyrule58
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate146:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'i':
+		goto yystate147
+	case c == 'r':
+		goto yystate151
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate147:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
 		goto yystate93
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'j' || c >= 'l' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+	case c == '[':
+		goto yystate97
+	case c == 'n':
+		goto yystate148
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate93:
+yystate148:
 	c = l.Next()
-	yyrule = 39
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule39
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
+	case c == 'u':
+		goto yystate149
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate149:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'e':
+		goto yystate150
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate150:
+	c = l.Next()
+	yyrule = 53
+	l.Mark()
+	switch {
+	default:
+		goto yyrule53
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate94:
+yystate151:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate95
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate152
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate95:
+yystate152:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate96
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'c':
+		goto yystate153
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate96:
+yystate153:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 's':
 		goto yystate97
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+	case c == 't':
+		goto yystate154
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate97:
+yystate154:
 	c = l.Next()
-	yyrule = 66
+	yyrule = 55
 	l.Mark()
 	switch {
 	default:
-		goto yyrule66
+		goto yyrule55
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate98:
+yystate155:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'a':
-		goto yystate99
-	case c == 'o':
-		goto yystate102
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate156
+	case c == 'e':
+		goto yystate159
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate99:
+yystate156:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 's':
-		goto yystate100
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 't':
+		goto yystate157
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate100:
+yystate157:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate101
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate158
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate101:
+yystate158:
 	c = l.Next()
 	yyrule = 54
 	l.Mark()
@@ -1380,435 +2320,544 @@ yystate101:
 	default:
 		goto yyrule54
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate102:
+yystate159:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate103
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'f':
+		goto yystate160
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate160:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'a':
+		goto yystate161
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate161:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'u':
+		goto yystate162
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate162:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'l':
+		goto yystate163
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate103:
+yystate163:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 't':
-		goto yystate104
+		goto yystate164
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate104:
+yystate164:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 77
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule77
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'i':
-		goto yystate105
-	case c == 'r':
-		goto yystate109
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate105:
+yystate165:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate106
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'l':
+		goto yystate166
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate106:
+yystate166:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'u':
+		goto yystate97
+	case c == 'i':
+		goto yystate167
+	case c == 's':
+		goto yystate169
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
 		goto yystate107
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
 	}
 
-yystate107:
+yystate167:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate108
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'f':
+		goto yystate168
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate108:
+yystate168:
 	c = l.Next()
-	yyrule = 40
+	yyrule = 66
 	l.Mark()
 	switch {
 	default:
-		goto yyrule40
+		goto yyrule66
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate109:
+yystate169:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate110
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate170
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate110:
+yystate170:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 67
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule67
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'c':
-		goto yystate111
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate111:
+yystate171:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate112
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate172
+	case c == 'i':
+		goto yystate176
+	case c == 'l':
+		goto yystate179
+	case c == 'o':
+		goto yystate183
+	case c == 'u':
+		goto yystate185
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c == 'j' || c == 'k' || c == 'm' || c == 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate112:
+yystate172:
 	c = l.Next()
-	yyrule = 42
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule42
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'l':
+		goto yystate173
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate113:
+yystate173:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate114
-	case c == 'e':
-		goto yystate117
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 's':
+		goto yystate174
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate114:
+yystate174:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate115
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate175
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate115:
+yystate175:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 70
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule70
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate116
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate116:
+yystate176:
 	c = l.Next()
-	yyrule = 41
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule41
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'l':
+		goto yystate177
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate117:
+yystate177:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'f':
-		goto yystate118
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate178
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate118:
+yystate178:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 88
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule88
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate119
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate119:
+yystate179:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'u':
-		goto yystate120
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'o':
+		goto yystate180
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate120:
+yystate180:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'l':
-		goto yystate121
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate181
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate121:
+yystate181:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 't':
-		goto yystate122
+		goto yystate182
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate122:
+yystate182:
 	c = l.Next()
-	yyrule = 56
+	yyrule = 84
 	l.Mark()
 	switch {
 	default:
-		goto yyrule56
+		goto yyrule84
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate123:
+yystate183:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'l':
-		goto yystate124
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate184
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate124:
+yystate184:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 72
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule72
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'i':
-		goto yystate125
-	case c == 's':
-		goto yystate127
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate125:
+yystate185:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'f':
-		goto yystate126
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'n':
+		goto yystate186
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate126:
+yystate186:
 	c = l.Next()
-	yyrule = 45
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule45
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'c':
+		goto yystate187
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate127:
+yystate187:
 	c = l.Next()
 	yyrule = 71
 	l.Mark()
@@ -1816,1119 +2865,1309 @@ yystate127:
 	default:
 		goto yyrule71
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate128
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate128:
+yystate188:
 	c = l.Next()
-	yyrule = 46
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule46
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate189
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate129:
+yystate189:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate130
-	case c == 'i':
-		goto yystate134
-	case c == 'l':
-		goto yystate137
-	case c == 'o':
-		goto yystate141
-	case c == 'u':
-		goto yystate143
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'h' || c == 'j' || c == 'k' || c == 'm' || c == 'n' || c >= 'p' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'x':
+		goto yystate190
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate130:
+yystate190:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'l':
-		goto yystate131
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'i':
+		goto yystate191
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate131:
+yystate191:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 's':
-		goto yystate132
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'r' || c >= 't' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'n':
+		goto yystate192
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate132:
+yystate192:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate133
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 't':
+		goto yystate193
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate133:
+yystate193:
 	c = l.Next()
-	yyrule = 49
+	yyrule = 80
 	l.Mark()
 	switch {
 	default:
-		goto yyrule49
+		goto yyrule80
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate134:
+yystate194:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'l':
-		goto yystate135
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'f':
+		goto yystate195
+	case c == 'm':
+		goto yystate196
+	case c == 'n':
+		goto yystate201
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'l' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate135:
+yystate195:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 65
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule65
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate136
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate136:
+yystate196:
 	c = l.Next()
-	yyrule = 67
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule67
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'p':
+		goto yystate197
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate137:
+yystate197:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'o':
-		goto yystate138
+		goto yystate198
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate138:
+yystate198:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate139
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate199
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate139:
+yystate199:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 't':
-		goto yystate140
+		goto yystate200
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate140:
+yystate200:
 	c = l.Next()
-	yyrule = 63
+	yyrule = 57
 	l.Mark()
 	switch {
 	default:
-		goto yyrule63
+		goto yyrule57
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate141:
+yystate201:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 73
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule73
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'r':
-		goto yystate142
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 't':
+		goto yystate202
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate142:
+yystate202:
 	c = l.Next()
-	yyrule = 51
+	yyrule = 79
 	l.Mark()
 	switch {
 	default:
-		goto yyrule51
+		goto yyrule79
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate143:
+yystate203:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate144
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'i':
+		goto yystate204
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate144:
+yystate204:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'c':
-		goto yystate145
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'b':
+		goto yystate205
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate145:
+yystate205:
 	c = l.Next()
-	yyrule = 50
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule50
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate206
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate146:
+yystate206:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate147
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate207
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate147:
+yystate207:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'x':
-		goto yystate148
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'w' || c == 'y' || c == 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate208
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
+	}
+
+yystate208:
+	c = l.Next()
+	yyrule = 92
+	l.Mark()
+	switch {
+	default:
+		goto yyrule92
+	case c == '(':
+		goto yystate92
+	case c == '.':
+		goto yystate93
+	case c == '[':
+		goto yystate97
+	case c == 'y':
+		goto yystate209
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate148:
+yystate209:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 56
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule56
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'i':
-		goto yystate149
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate149:
+yystate210:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate150
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate211
+	case c == 'o':
+		goto yystate213
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate150:
+yystate211:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate151
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'p':
+		goto yystate212
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate151:
+yystate212:
 	c = l.Next()
-	yyrule = 59
+	yyrule = 83
 	l.Mark()
 	switch {
 	default:
-		goto yyrule59
+		goto yyrule83
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate152:
+yystate213:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'f':
-		goto yystate153
+		goto yystate97
 	case c == 'n':
-		goto yystate154
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'e' || c >= 'g' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate214
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate153:
+yystate214:
 	c = l.Next()
-	yyrule = 44
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule44
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate215
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate154:
+yystate215:
 	c = l.Next()
-	yyrule = 52
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule52
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate155
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'y':
+		goto yystate216
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate155:
+yystate216:
 	c = l.Next()
-	yyrule = 58
+	yyrule = 85
 	l.Mark()
 	switch {
 	default:
-		goto yyrule58
+		goto yyrule85
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate156:
+yystate217:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate157
-	case c == 'o':
-		goto yystate159
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 'n' || c >= 'p' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'b':
+		goto yystate218
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate157:
+yystate218:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'p':
-		goto yystate158
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'j':
+		goto yystate219
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'i' || c >= 'k' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate158:
+yystate219:
 	c = l.Next()
-	yyrule = 62
+	yyrule = 86
 	l.Mark()
 	switch {
 	default:
-		goto yyrule62
+		goto yyrule86
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate159:
+yystate220:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate160
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate221
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate160:
+yystate221:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate161
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'a':
+		goto yystate222
+	case c == 't':
+		goto yystate224
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate161:
+yystate222:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'y':
-		goto yystate162
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'd':
+		goto yystate223
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate162:
+yystate223:
 	c = l.Next()
-	yyrule = 64
+	yyrule = 76
 	l.Mark()
 	switch {
 	default:
-		goto yyrule64
+		goto yyrule76
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate163:
+yystate224:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'b':
-		goto yystate164
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c >= 'c' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'u':
+		goto yystate225
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate164:
+yystate225:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'j':
-		goto yystate165
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'i' || c >= 'k' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate226
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate165:
+yystate226:
 	c = l.Next()
-	yyrule = 65
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule65
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'n':
+		goto yystate227
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate166:
+yystate227:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 68
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule68
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'e':
-		goto yystate167
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate167:
+yystate228:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'a':
-		goto yystate168
+		goto yystate97
 	case c == 't':
-		goto yystate170
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'b' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate229
+	case c == 'w':
+		goto yystate234
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c == 'u' || c == 'v' || c >= 'x' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate168:
+yystate229:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'd':
-		goto yystate169
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'c' || c >= 'e' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate230
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate169:
+yystate230:
 	c = l.Next()
-	yyrule = 55
+	yyrule = 81
 	l.Mark()
 	switch {
 	default:
-		goto yyrule55
+		goto yyrule81
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'u':
+		goto yystate231
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate170:
+yystate231:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'u':
-		goto yystate171
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'c':
+		goto yystate232
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate171:
+yystate232:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'r':
-		goto yystate172
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 't':
+		goto yystate233
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate172:
+yystate233:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 63
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule63
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'n':
-		goto yystate173
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'm' || c >= 'o' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate173:
+yystate234:
 	c = l.Next()
-	yyrule = 47
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule47
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'i':
+		goto yystate235
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate174:
+yystate235:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 't':
-		goto yystate175
-	case c == 'w':
-		goto yystate177
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c == 'u' || c == 'v' || c >= 'x' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate236
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate175:
+yystate236:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'r':
-		goto yystate176
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'c':
+		goto yystate237
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate176:
+yystate237:
 	c = l.Next()
-	yyrule = 60
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule60
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'h':
+		goto yystate238
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate177:
+yystate238:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 74
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule74
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'i':
-		goto yystate178
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate178:
+yystate239:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 't':
-		goto yystate179
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 's' || c >= 'u' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'r':
+		goto yystate240
+	case c == 'y':
+		goto yystate244
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate179:
+yystate240:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'c':
-		goto yystate180
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c == 'a' || c == 'b' || c >= 'd' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'u':
+		goto yystate241
+	case c == 'y':
+		goto yystate243
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'x' || c == 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate180:
+yystate241:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'h':
-		goto yystate181
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'e':
+		goto yystate242
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate181:
+yystate242:
 	c = l.Next()
-	yyrule = 53
+	yyrule = 69
 	l.Mark()
 	switch {
 	default:
-		goto yyrule53
+		goto yyrule69
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate182:
+yystate243:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 60
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule60
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'r':
-		goto yystate183
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'q' || c >= 's' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate183:
+yystate244:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
-	case c == 'u':
-		goto yystate184
-	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 't' || c >= 'v' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate97
+	case c == 'p':
+		goto yystate245
+	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'o' || c >= 'q' && c <= 'z' || c == '\u0080' || c == '\u0081':
+		goto yystate107
 	}
 
-yystate184:
+yystate245:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'e':
-		goto yystate185
+		goto yystate246
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate185:
+yystate246:
 	c = l.Next()
-	yyrule = 48
+	yyrule = 62
 	l.Mark()
 	switch {
 	default:
-		goto yyrule48
+		goto yyrule62
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate186:
+yystate247:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'h':
-		goto yystate187
+		goto yystate248
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'g' || c >= 'i' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate187:
+yystate248:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'i':
-		goto yystate188
+		goto yystate249
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'h' || c >= 'j' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate188:
+yystate249:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'l':
-		goto yystate189
+		goto yystate250
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'k' || c >= 'm' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate189:
+yystate250:
 	c = l.Next()
-	yyrule = 71
+	yyrule = 92
 	l.Mark()
 	switch {
 	default:
-		goto yyrule71
+		goto yyrule92
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c == 'e':
-		goto yystate190
+		goto yystate251
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'd' || c >= 'f' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate190:
+yystate251:
 	c = l.Next()
-	yyrule = 43
+	yyrule = 64
 	l.Mark()
 	switch {
 	default:
-		goto yyrule43
+		goto yyrule64
 	case c == '(':
-		goto yystate76
+		goto yystate92
+	case c == '.':
+		goto yystate93
 	case c == '[':
-		goto yystate77
+		goto yystate97
 	case c >= '0' && c <= '9' || c >= 'A' && c <= 'Z' || c == '_' || c >= 'a' && c <= 'z' || c == '\u0080' || c == '\u0081':
-		goto yystate75
+		goto yystate107
 	}
 
-yystate191:
+yystate252:
 	c = l.Next()
-	yyrule = 21
+	yyrule = 26
 	l.Mark()
 	switch {
 	default:
-		goto yyrule21
+		goto yyrule26
 	case c == '\n':
-		goto yystate192
+		goto yystate253
 	case c == '\t' || c == ' ':
-		goto yystate191
+		goto yystate252
 	}
 
-yystate192:
+yystate253:
 	c = l.Next()
-	yyrule = 21
+	yyrule = 26
 	l.Mark()
-	goto yyrule21
+	goto yyrule26
 
-yystate193:
+yystate254:
 	c = l.Next()
+	yyrule = 40
+	l.Mark()
 	switch {
 	default:
-		goto yyabort
+		goto yyrule40
+	case c == '=':
+		goto yystate257
+	case c == '\n':
+		goto yystate256
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate255
 	case c == '|':
-		goto yystate194
+		goto yystate258
 	}
 
-yystate194:
+yystate255:
 	c = l.Next()
-	yyrule = 26
+	yyrule = 40
 	l.Mark()
-	goto yyrule26
+	switch {
+	default:
+		goto yyrule40
+	case c == '\n':
+		goto yystate256
+	case c == '\t' || c == '\r' || c == ' ':
+		goto yystate255
+	}
 
-yystate195:
+yystate256:
 	c = l.Next()
-	yyrule = 22
+	yyrule = 40
 	l.Mark()
-	goto yyrule22
+	goto yyrule40
+
+yystate257:
+	c = l.Next()
+	yyrule = 10
+	l.Mark()
+	goto yyrule10
+
+yystate258:
+	c = l.Next()
+	yyrule = 31
+	l.Mark()
+	goto yyrule31
+
+yystate259:
+	c = l.Next()
+	yyrule = 27
+	l.Mark()
+	goto yyrule27
+
+yystate260:
+	c = l.Next()
+	yyrule = 44
+	l.Mark()
+	goto yyrule44
 
 yyrule1: // [ \t\r ]+
 	{
 		// ignore all whitespace
 		goto yystate0
 	}
-yyrule2: // \/\*(.|\n)*\*\/
+yyrule2: // \/\*([^*]|\*+[^*\/])*\*+\/
 	{
-		// ignore comments
+		l.comment()
 		goto yystate0
 	}
-yyrule3: // \/\/(.)*\n
+yyrule3: // \/\/(.)*\n?
 	{
-		return l.char(NEWLINE)
+		{
+			l.comment()
+			return l.char(NEWLINE)
+		}
+		goto yystate0
 	}
 yyrule4: // \+=
 	{
@@ -2950,243 +4189,335 @@ yyrule8: // %=
 	{
 		return l.char(MOD_ASSIGN)
 	}
-yyrule9: // =
+yyrule9: // &=
+	{
+		return l.char(AND_ASSIGN)
+	}
+yyrule10: // \|=
+	{
+		return l.char(OR_ASSIGN)
+	}
+yyrule11: // \^=
+	{
+		return l.char(XOR_ASSIGN)
+	}
+yyrule12: // \<\<=
+	{
+		return l.char(LSHIFT_ASSIGN)
+	}
+yyrule13: // >>=
+	{
+		return l.char(RSHIFT_ASSIGN)
+	}
+yyrule14: // =
 	{
 		return l.char(ASSIGN)
 	}
-yyrule10: // \n
+yyrule15: // \n
 	{
 		return l.char(NEWLINE)
 	}
-yyrule11: // ;
+yyrule16: // ;
 	{
 		return l.char(NEWLINE)
 	}
-yyrule12: // ,[ \t\r]*\n?
+yyrule17: // ,[ \t\r]*\n?
 	{
 		return l.char(COMMA)
 	}
-yyrule13: // :
+yyrule18: // :
 	{
 		return l.char(COLON)
 	}
-yyrule14: // \.\.
+yyrule19: // \.\.
 	{
 		return l.char(DOUBLEDOT)
 	}
-yyrule15: // \.
+yyrule20: // \.
 	{
 		return l.char(DOT)
 	}
-yyrule16: // \?
+yyrule21: // \?
 	{
 		return l.char(QUESTION)
 	}
-yyrule17: // \([ \t]*\n?
+yyrule22: // \([ \t]*\n?
 	{
 		return l.char(LPAREN)
 	}
-yyrule18: // \n[ \t]*\)
+yyrule23: // \n[ \t]*\)
 	{
 		return l.char(RPAREN)
 	}
-yyrule19: // \)
+yyrule24: // \)
 	{
 		return l.char(RPAREN)
 	}
-yyrule20: // @\{[ \t]*\n?
+yyrule25: // @\{[ \t]*\n?
 	{
 		return l.char(OBJ)
 	}
-yyrule21: // \{[ \t]*\n?
+yyrule26: // \{[ \t]*\n?
 	{
 		return l.char(LBRACE)
 	}
-yyrule22: // \}
+yyrule27: // \}
 	{
 		return l.char(RBRACE)
 	}
-yyrule23: // \[[ \t]*\n?
+yyrule28: // \[[ \t]*\n?
 	{
 		return l.char(LBRACKET)
 	}
-yyrule24: // \]
+yyrule29: // \]
 	{
 		return l.char(RBRACKET)
 	}
-yyrule25: // &&
+yyrule30: // &&
 	{
 		return l.char(AND)
 	}
-yyrule26: // \|\|
+yyrule31: // \|\|
 	{
 		return l.char(OR)
 	}
-yyrule27: // \+[ \t\r]*\n?
+yyrule32: // \+\+
+	{
+		return l.char(INC)
+	}
+yyrule33: // --
+	{
+		return l.char(DEC)
+	}
+yyrule34: // \+[ \t\r]*\n?
 	{
 		return l.char(ADD)
 	}
-yyrule28: // -[ \t\r]*\n?
+yyrule35: // -[ \t\r]*\n?
 	{
 		return l.char(SUB)
 	}
-yyrule29: // \*[ \t\r]*\n?
+yyrule36: // \*[ \t\r]*\n?
 	{
 		return l.char(MUL)
 	}
-yyrule30: // \/[ \t\r]*\n?
+yyrule37: // \/[ \t\r]*\n?
 	{
 		return l.char(DIV)
 	}
-yyrule31: // %[ \t\r]*\n?
+yyrule38: // %[ \t\r]*\n?
 	{
 		return l.char(MOD)
 	}
-yyrule32: // ==[ \t\r]*\n?
+yyrule39: // &[ \t\r]*\n?
+	{
+		return l.char(BIT_AND)
+	}
+yyrule40: // \|[ \t\r]*\n?
+	{
+		return l.char(BIT_OR)
+	}
+yyrule41: // \^[ \t\r]*\n?
+	{
+		return l.char(BIT_XOR)
+	}
+yyrule42: // \<\<[ \t\r]*\n?
+	{
+		return l.char(LSHIFT)
+	}
+yyrule43: // >>[ \t\r]*\n?
+	{
+		return l.char(RSHIFT)
+	}
+yyrule44: // ~
+	{
+		return l.char(BIT_NOT)
+	}
+yyrule45: // ==[ \t\r]*\n?
 	{
 		return l.char(EQ)
 	}
-yyrule33: // !=[ \t\r]*\n?
+yyrule46: // !=[ \t\r]*\n?
 	{
 		return l.char(NOT_EQ)
 	}
-yyrule34: // !
+yyrule47: // !
 	{
 		return l.char(NOT)
 	}
-yyrule35: // \<=[ \t\r]*\n?
+yyrule48: // \<=[ \t\r]*\n?
 	{
 		return l.char(LTE)
 	}
-yyrule36: // >=[ \t\r]*\n?
+yyrule49: // >=[ \t\r]*\n?
 	{
 		return l.char(GTE)
 	}
-yyrule37: // \<[ \t\r]*\n?
+yyrule50: // \<[ \t\r]*\n?
 	{
 		return l.char(LT)
 	}
-yyrule38: // >[ \t\r]*\n?
+yyrule51: // >[ \t\r]*\n?
 	{
 		return l.char(GT)
 	}
-yyrule39: // break
+yyrule52: // break
 	{
 		return l.char(BREAK)
 	}
-yyrule40: // continue
+yyrule53: // continue
 	{
 		return l.char(CONTINUE)
 	}
-yyrule41: // data
+yyrule54: // data
 	{
 		return l.char(DATA)
 	}
-yyrule42: // contract
+yyrule55: // contract
+	{
+		{
+			lval.b = false
+			return l.char(CONTRACT)
+		}
+		goto yystate0
+	}
+yyrule56: // library
+	{
+		{
+			lval.b = true // 库的声明和合约相同
+			return l.char(CONTRACT)
+		}
+		goto yystate0
+	}
+yyrule57: // import
+	{
+		return l.char(IMPORT)
+	}
+yyrule58: // conditions
+	{
+		return l.char(CONDITIONS)
+	}
+yyrule59: // action
+	{
+		return l.char(ACTION)
+	}
+yyrule60: // try
+	{
+		return l.char(TRY)
+	}
+yyrule61: // catch
+	{
+		return l.char(CATCH)
+	}
+yyrule62: // type
+	{
+		return l.char(TYPE)
+	}
+yyrule63: // struct
 	{
-		return l.char(CONTRACT)
+		return l.char(STRUCT)
 	}
-yyrule43: // while
+yyrule64: // while
 	{
 		return l.char(WHILE)
 	}
-yyrule44: // if
+yyrule65: // if
 	{
 		return l.char(IF)
 	}
-yyrule45: // elif
+yyrule66: // elif
 	{
 		return l.char(ELIF)
 	}
-yyrule46: // else
+yyrule67: // else
 	{
 		return l.char(ELSE)
 	}
-yyrule47: // return
+yyrule68: // return
 	{
 		return l.char(RETURN)
 	}
-yyrule48: // true
+yyrule69: // true
 	{
 		return l.char(TRUE)
 	}
-yyrule49: // false
+yyrule70: // false
 	{
 		return l.char(FALSE)
 	}
-yyrule50: // func
+yyrule71: // func
 	{
 		return l.char(FUNC)
 	}
-yyrule51: // for
+yyrule72: // for
 	{
 		return l.char(FOR)
 	}
-yyrule52: // in
+yyrule73: // in
 	{
 		return l.char(IN)
 	}
-yyrule53: // switch
+yyrule74: // switch
 	{
 		return l.char(SWITCH)
 	}
-yyrule54: // case
+yyrule75: // case
 	{
 		return l.char(CASE)
 	}
-yyrule55: // read
+yyrule76: // read
 	{
 		return l.char(READ)
 	}
-yyrule56: // default
+yyrule77: // default
 	{
 		return l.char(DEFAULT)
 	}
-yyrule57: // bool
+yyrule78: // bool
 	{
 		return l.char(T_BOOL)
 	}
-yyrule58: // int
+yyrule79: // int
 	{
 		return l.char(T_INT)
 	}
-yyrule59: // hexint
+yyrule80: // hexint
 	{
 		return l.char(T_INT)
 	}
-yyrule60: // str
+yyrule81: // str
 	{
 		return l.char(T_STR)
 	}
-yyrule61: // arr
+yyrule82: // arr
 	{
 		return l.char(T_ARR)
 	}
-yyrule62: // map
+yyrule83: // map
 	{
 		return l.char(T_MAP)
 	}
-yyrule63: // float
+yyrule84: // float
 	{
 		return l.char(T_FLOAT)
 	}
-yyrule64: // money
+yyrule85: // money
 	{
 		return l.char(T_MONEY)
 	}
-yyrule65: // obj
+yyrule86: // obj
 	{
 		return l.char(T_OBJECT)
 	}
-yyrule66: // bytes
+yyrule87: // bytes
 	{
 		return l.char(T_BYTES)
 	}
-yyrule67: // file
+yyrule88: // file
 	{
 		return l.char(T_FILE)
 	}
-yyrule68: // {float}
+yyrule89: // {float}
 	{
 		{
 			ai, _ := strconv.ParseFloat(string(l.TokenBytes(nil)), 64)
@@ -3195,7 +4526,7 @@ yyrule68: // {float}
 		}
 		goto yystate0
 	}
-yyrule69: // {hexint}
+yyrule90: // {hexint}
 	{
 		{
 			val, _ := strconv.ParseInt(string(l.TokenBytes(nil)), 0, 64)
@@ -3204,7 +4535,7 @@ yyrule69: // {hexint}
 		}
 		goto yystate0
 	}
-yyrule70: // {int}
+yyrule91: // {int}
 	{
 		{
 			ai, _ := strconv.Atoi(string(l.TokenBytes(nil)))
@@ -3213,7 +4544,7 @@ yyrule70: // {int}
 		}
 		goto yystate0
 	}
-yyrule71: // {identifier}
+yyrule92: // {identifier}
 	{
 		{
 			lval.s = string(l.TokenBytes(nil))
@@ -3221,7 +4552,7 @@ yyrule71: // {identifier}
 		}
 		goto yystate0
 	}
-yyrule72: // {env}
+yyrule93: // {env}
 	{
 		{
 			lval.s = string(l.TokenBytes(nil))
@@ -3230,10 +4561,14 @@ yyrule72: // {env}
 		}
 		goto yystate0
 	}
-yyrule73: // {string}
+yyrule94: // {string}
 	{
 		{
 			var err error
+			if strings.Contains(string(l.TokenBytes(nil)), "${") {
+				lval.s = string(l.TokenBytes(nil))
+				return l.char(ISTRING)
+			}
 			s := strings.Replace(string(l.TokenBytes(nil)), "\n", `\n`, -1)
 			lval.s, err = strconv.Unquote(`"` + s[1:len(s)-1] + `"`)
 			if err != nil {
@@ -3243,7 +4578,7 @@ yyrule73: // {string}
 		}
 		goto yystate0
 	}
-yyrule74: // {qstring}
+yyrule95: // {qstring}
 	{
 		{
 			s := string(l.TokenBytes(nil))
@@ -3252,7 +4587,7 @@ yyrule74: // {qstring}
 		}
 		goto yystate0
 	}
-yyrule75: // {call}
+yyrule96: // {call}
 	{
 		{
 			lval.s = string(l.TokenBytes(nil))
@@ -3261,7 +4596,7 @@ yyrule75: // {call}
 		}
 		goto yystate0
 	}
-yyrule76: // {callcontract}
+yyrule97: // {callcontract}
 	{
 		{
 			lval.s = string(l.TokenBytes(nil))
@@ -3270,8 +4605,25 @@ yyrule76: // {callcontract}
 		}
 		goto yystate0
 	}
-yyrule77: // {index}
+yyrule98: // {field}
+	{
+		{
+			lval.s = string(l.TokenBytes(nil))
+			return l.char(FIELD)
+		}
+		goto yystate0
+	}
+yyrule99: // {structvalue}
 	{
+		{
+			lval.s = strings.TrimRight(string(l.TokenBytes(nil)), " \t\r\n")
+			lval.s = lval.s[:len(lval.s)-1]
+			return l.char(STRUCTVALUE)
+		}
+		goto yystate0
+	}
+yyrule100: // {index}
+	if true { // avoid go vet determining the below panic will not be reached
 		{
 			lval.s = string(l.TokenBytes(nil))
 			lval.s = lval.s[:len(lval.s)-1]
@@ -3281,9 +4633,20 @@ yyrule77: // {index}
 	}
 	panic("unreachable")
 
-	goto yyabort // silence unused label error
-
 yyabort: // no lexem recognized
+	// silence unused label errors for build and satisfy go vet reachability analysis
+	{
+		if false {
+			goto yyabort
+		}
+		if false {
+			goto yystate0
+		}
+		if false {
+			goto yystate1
+		}
+	}
+
 	if c, ok := l.Abort(); ok {
 		return l.char(c)
 	}
@@ -1,7 +1,10 @@
 package parser
 
 import (
+	"fmt"
 	"math/rand"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -42,6 +45,15 @@ const (
 	TObjList
 	TSwitch
 	TCase
+	TImport
+	TConditions
+	TAction
+	TTry
+	TMultiAssign
+	TStruct
+	TStructValue
+	TField
+	TSlice
 )
 
 var (
@@ -81,6 +93,15 @@ var (
 		33: "TObjList",
 		34: "TSwitch",
 		35: "TCase",
+		36: "TImport",
+		37: "TConditions",
+		38: "TAction",
+		39: "TTry",
+		40: "TMultiAssign",
+		41: "TStruct",
+		42: "TStructValue",
+		43: "TField",
+		44: "TSlice",
 	}
 )
 
@@ -105,6 +126,7 @@ const (
 	VBytes  // bytes
 	VFile   // file
 	VObjList
+	VStruct // the struct type has the index of the struct in the upper 16 bits
 )
 
 // NSwitch - switch statement
@@ -145,12 +167,14 @@ type NVar struct {
 	Type *Node
 	Name string
 	Exp  *Node
+	Attr string `json:",omitempty"` // the attributes of the data parameter like "required,min=0"
 }
 
 // NType contains the type
 type NType struct {
 	Type int64
 	Def  bool
+	Name string // the name of the struct type, it is resolved by the compiler
 }
 
 // NEnv contains a name of environment variable
@@ -208,22 +232,25 @@ type NGetIndex struct {
 
 // NFunc - function
 type NFunc struct {
-	Name   string
-	Result *Node
-	Params []NVar
-	Body   *Node
+	Name    string
+	Result  *Node
+	Results []*Node // the types of the multiple results like (int, str), Result is nil then
+	Params  []NVar
+	Body    *Node
 }
 
 // NCallFunc - call function
 type NCallFunc struct {
 	Name   string
 	Params *Node
+	Interp string // the source of "...${expr}..." literal which has been replaced with Sprintf
 }
 
 // NCallContract - call contract
 type NCallContract struct {
-	Name   string
-	Params []ContractParam
+	Name    string
+	Version int // the pinned version of the contract, 0 is the current version
+	Params  []ContractParam
 }
 
 // NIf - if statement
@@ -294,22 +321,85 @@ type NVars struct {
 // NReturn is a return statement
 type NReturn struct {
 	Expr *Node
+	List []*Node // the values of the function with multiple results, Expr is nil then
 }
 
 // NContract is a root node
 type NContract struct {
-	Name  string // the name of the contract
-	Read  bool
-	Block *Node
+	Name    string // the name of the contract
+	Read    bool
+	Library bool // the library contains the functions which can be imported by contracts
+	Block   *Node
+}
+
+// NImport - import of the library
+type NImport struct {
+	Name string
+}
+
+// NSection - conditions or action section of the contract
+type NSection struct {
+	Body *Node
+}
+
+// NTry - try statement, the error is assigned to the variable of catch
+type NTry struct {
+	Body  *Node
+	Var   string
+	Catch *Node
+}
+
+// NStruct - declaration of the struct type
+type NStruct struct {
+	Name   string
+	Fields []NVar
+}
+
+// NStructValue - struct literal like Order{id: "x", amount: money(5)}
+type NStructValue struct {
+	Name   string
+	Fields []ContractParam
+}
+
+// NField - access to the field of the struct like o.amount
+type NField struct {
+	Expr *Node
+	Name string
+}
+
+// NSlice - the part of arr, bytes or str like a[1:3], From or To can be nil
+type NSlice struct {
+	Expr *Node
+	From *Node
+	To   *Node
+}
+
+// NMultiAssign - assignment of the multiple results of the function like q, r = divmod(a, b)
+type NMultiAssign struct {
+	Vars []*Node
+	Expr *Node
 }
 
 // Node is a common node structure for yacc
 type Node struct {
-	Type   int
-	Line   int
-	Column uint32
-	Result uint32
-	Value  interface{}
+	Type     int
+	Line     int
+	Column   uint32
+	Result   uint32
+	Value    interface{}
+	Begin    Position   // the position of the first character
+	Finish   Position   // the position after the last character
+	Comments []*Comment // the comments which are attached to the node
+}
+
+// Pos returns the position of the first character of the node
+func (node *Node) Pos() Position {
+	return node.Begin
+}
+
+// End returns the position immediately after the node
+func (node *Node) End() Position {
+	return node.Finish
 }
 
 func init() {
@@ -333,6 +423,34 @@ func setPos(node *Node, l yyLexer) *Node {
 	return node
 }
 
+func setBegin(node *Node, pos Position) *Node {
+	if node != nil {
+		node.Begin = pos
+	}
+	return node
+}
+
+func setFinish(node *Node, pos Position) *Node {
+	if node != nil {
+		node.Finish = pos
+	}
+	return node
+}
+
+func setRange(node *Node, begin, finish Position) *Node {
+	return setFinish(setBegin(node, begin), finish)
+}
+
+// lastPos returns the last defined position
+func lastPos(list ...Position) (ret Position) {
+	for _, pos := range list {
+		if pos.Line > 0 {
+			ret = pos
+		}
+	}
+	return
+}
+
 func newBreak(l yyLexer) *Node {
 	return setPos(&Node{
 		Type: TBreak,
@@ -345,13 +463,156 @@ func newContinue(l yyLexer) *Node {
 	}, l)
 }
 
-func newContract(name string, read bool, block *Node, l yyLexer) *Node {
+func newContract(name string, read, library bool, block *Node, l yyLexer) *Node {
 	return setPos(&Node{
 		Type: TContract,
 		Value: &NContract{
-			Name:  name,
-			Read:  read,
-			Block: block,
+			Name:    name,
+			Read:    read,
+			Library: library,
+			Block:   block,
+		},
+	}, l)
+}
+
+func newSection(ntype int, body *Node, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: ntype,
+		Value: &NSection{
+			Body: body,
+		},
+	}, l)
+}
+
+func newTry(body *Node, name string, catch *Node, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TTry,
+		Value: &NTry{
+			Body:  body,
+			Var:   name,
+			Catch: catch,
+		},
+	}, l)
+}
+
+func newStruct(name string, fields []NVar, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TStruct,
+		Value: &NStruct{
+			Name:   name,
+			Fields: fields,
+		},
+	}, l)
+}
+
+func newStructValue(name string, params *Node, l yyLexer) *Node {
+	var list []ContractParam
+	if params != nil {
+		list = params.Value.(*NContractParams).Params
+	}
+	return setPos(&Node{
+		Type: TStructValue,
+		Value: &NStructValue{
+			Name:   name,
+			Fields: list,
+		},
+	}, l)
+}
+
+func newSlice(expr *Node, bounds []*Node, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TSlice,
+		Value: &NSlice{
+			Expr: expr,
+			From: bounds[0],
+			To:   bounds[1],
+		},
+	}, l)
+}
+
+func newField(expr *Node, name string, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TField,
+		Value: &NField{
+			Expr: expr,
+			Name: name,
+		},
+	}, l)
+}
+
+// newTypeChain creates the type like arr.map.int which is scanned as one token. The position
+// is the beginning of the token like in the case of the separate tokens.
+func newTypeChain(name string, pos Position, l yyLexer) *Node {
+	var tNode *Node
+	for i, item := range strings.Split(name, `.`) {
+		itype := int64(VVoid)
+		for key, value := range typeNames {
+			if value == item {
+				itype = key
+				break
+			}
+		}
+		if i == 0 {
+			tNode = newType(itype, l)
+		} else if itype == VVoid {
+			tNode.Value.(*NType).Type = VVoid
+		} else if tNode.Value.(*NType).Type != VVoid {
+			addSubtype(tNode, itype, l)
+		}
+	}
+	tNode.Line, tNode.Column = pos.Line, uint32(pos.Column)
+	return tNode
+}
+
+// newFieldChain creates the access to the fields like o.item.name which is scanned as one token
+func newFieldChain(name string, l yyLexer) *Node {
+	names := strings.Split(name, `.`)
+	node := newGetVar(names[0], l)
+	for _, item := range names[1:] {
+		node = newField(node, item, l)
+	}
+	return node
+}
+
+func newStructType(name string, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TType,
+		Value: &NType{
+			Type: VStruct,
+			Name: name,
+		},
+	}, l)
+}
+
+func newMultiAssign(vars []*Node, expr *Node, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TMultiAssign,
+		Value: &NMultiAssign{
+			Vars: vars,
+			Expr: expr,
+		},
+	}, l)
+}
+
+func newReturnList(expr *Node, list *Node, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TReturn,
+		Value: &NReturn{
+			List: append([]*Node{expr}, list.Value.(*NArray).List...),
+		},
+	}, l)
+}
+
+func setResults(node *Node, results []*Node) *Node {
+	node.Value.(*NFunc).Results = results
+	return node
+}
+
+func newImport(name string, l yyLexer) *Node {
+	return setPos(&Node{
+		Type: TImport,
+		Value: &NImport{
+			Name: name,
 		},
 	}, l)
 }
@@ -395,6 +656,8 @@ func newType(itype int64, l yyLexer) *Node {
 
 func addSubtype(tNode *Node, ichild int64, l yyLexer) *Node {
 	itype := tNode.Value.(*NType).Type
+	// the struct types cannot have subtypes, the type becomes invalid
+	tNode.Value.(*NType).Name = ``
 	var (
 		i uint64
 	)
@@ -481,6 +744,13 @@ func newVars(vtype *Node, vars []string) []NVar {
 	return va
 }
 
+func setAttr(vars []NVar, attr string) []NVar {
+	for i := range vars {
+		vars[i].Attr = attr
+	}
+	return vars
+}
+
 func newVarExp(vtype *Node, name string, exp *Node, l yyLexer) []NVar {
 	va := make([]NVar, 1)
 	va = []NVar{
@@ -555,6 +825,11 @@ func newBinary(left *Node, right *Node, oper int, l yyLexer) *Node {
 	}, l)
 }
 
+// newIncDec returns x++ or x-- which are compiled as x += 1 and x -= 1
+func newIncDec(left *Node, oper int, begin, finish Position, l yyLexer) *Node {
+	return newBinary(left, setRange(newValue(int64(1), l), begin, finish), oper, l)
+}
+
 func newVarValue(name string, l yyLexer) *Node {
 	return setPos(&Node{
 		Type: TSetVar,
@@ -725,15 +1000,35 @@ func newCallContract(name string, params *Node, l yyLexer) *Node {
 	if params != nil {
 		list = params.Value.(*NContractParams).Params
 	}
+	name, version := splitVersion(name[1:])
 	return setPos(&Node{
 		Type: TCallContract,
 		Value: &NCallContract{
-			Name:   name[1:],
-			Params: list,
+			Name:    name,
+			Version: version,
+			Params:  list,
 		},
 	}, l)
 }
 
+// VersionName returns the name of the version of the contract, like Token.v2
+func VersionName(name string, version int) string {
+	if version == 0 {
+		return name
+	}
+	return fmt.Sprintf(`%s.v%d`, name, version)
+}
+
+// splitVersion splits Token.v2 into the name and the version of the contract
+func splitVersion(name string) (string, int) {
+	if off := strings.Index(name, `.v`); off > 0 {
+		if version, err := strconv.Atoi(name[off+2:]); err == nil {
+			return name[:off], version
+		}
+	}
+	return name, 0
+}
+
 func newArray(par *Node, l yyLexer) *Node {
 	return setPos(&Node{
 		Type: TArray,
@@ -841,15 +1136,37 @@ func newSwitch(expr *Node, icase *Node, def *Node, l yyLexer) *Node {
 
 // Parser creates AST
 func Parser(input string) (*Node, error) {
+	list, err := parse(``, input, false)
+	if err != nil {
+		return nil, err
+	}
+	return list[0], nil
+}
+
+// ParseFile creates AST of the contracts of the source file. The file can contain several
+// contracts, the name of the file is included in the errors.
+func ParseFile(filename, input string) ([]*Node, error) {
+	return parse(filename, input, true)
+}
+
+func parse(filename, input string, multi bool) ([]*Node, error) {
 	yyErrorVerbose = true
 
-	l, err := NewLexer(``, input)
+	l, err := NewLexer(filename, input)
 	if err != nil {
 		return nil, err
 	}
-	yyParse(l)
-	if l.err != nil {
-		return nil, l.err
+	l.multi = multi
+	for {
+		l.parsed = len(l.result)
+		yyParse(l)
+		if l.err != nil {
+			return nil, l.err
+		}
+		if l.next == nil {
+			break
+		}
 	}
-	return l.result.(*Node), nil
+	attachFile(l.result, l.comments, l.data)
+	return l.result, nil
 }
@@ -7,11 +7,17 @@ import __yyfmt__ "fmt"
 
 //line parser.y:2
 
+const errDataFirst = `data must be declared before statements`
+
 func setResult(l yyLexer, v *Node) {
-	l.(*lexer).result = v
+	l.(*lexer).result = append(l.(*lexer).result, v)
+}
+
+func setData(l yyLexer, block *Node, begin, end Position) {
+	l.(*lexer).data[block] = []Position{begin, end}
 }
 
-//line parser.y:10
+//line parser.y:16
 type yySymType struct {
 	yys int
 	n   *Node
@@ -21,6 +27,9 @@ type yySymType struct {
 	s   string
 	sa  []string
 	va  []NVar
+	na  []*Node
+	p   Position // the position of the first token
+	e   Position // the position after the last token
 }
 
 const IDENT = 57346
@@ -28,73 +37,96 @@ const ENV = 57347
 const CALL = 57348
 const CALLCONTRACT = 57349
 const INDEX = 57350
-const INT = 57351
-const FLOAT = 57352
-const STRING = 57353
-const QSTRING = 57354
-const TRUE = 57355
-const FALSE = 57356
-const NEWLINE = 57357
-const COMMA = 57358
-const COLON = 57359
-const LPAREN = 57360
-const RPAREN = 57361
-const OBJ = 57362
-const LBRACE = 57363
-const RBRACE = 57364
-const LBRACKET = 57365
-const RBRACKET = 57366
-const QUESTION = 57367
-const DOUBLEDOT = 57368
-const DOT = 57369
-const ADD = 57370
-const SUB = 57371
-const MUL = 57372
-const DIV = 57373
-const MOD = 57374
-const ADD_ASSIGN = 57375
-const SUB_ASSIGN = 57376
-const MUL_ASSIGN = 57377
-const DIV_ASSIGN = 57378
-const MOD_ASSIGN = 57379
-const ASSIGN = 57380
-const AND = 57381
-const OR = 57382
-const EQ = 57383
-const NOT_EQ = 57384
-const NOT = 57385
-const LT = 57386
-const GT = 57387
-const LTE = 57388
-const GTE = 57389
-const BREAK = 57390
-const CONTINUE = 57391
-const DATA = 57392
-const CONTRACT = 57393
-const IF = 57394
-const ELIF = 57395
-const ELSE = 57396
-const RETURN = 57397
-const WHILE = 57398
-const FUNC = 57399
-const FOR = 57400
-const IN = 57401
-const SWITCH = 57402
-const CASE = 57403
-const READ = 57404
-const DEFAULT = 57405
-const T_INT = 57406
-const T_BOOL = 57407
-const T_STR = 57408
-const T_ARR = 57409
-const T_MAP = 57410
-const T_FLOAT = 57411
-const T_MONEY = 57412
-const T_OBJECT = 57413
-const T_BYTES = 57414
-const T_FILE = 57415
-const UNARYMINUS = 57416
-const UNARYNOT = 57417
+const STRUCTVALUE = 57351
+const FIELD = 57352
+const INT = 57353
+const FLOAT = 57354
+const STRING = 57355
+const QSTRING = 57356
+const ISTRING = 57357
+const TRUE = 57358
+const FALSE = 57359
+const NEWLINE = 57360
+const COMMA = 57361
+const COLON = 57362
+const LPAREN = 57363
+const RPAREN = 57364
+const OBJ = 57365
+const LBRACE = 57366
+const RBRACE = 57367
+const LBRACKET = 57368
+const RBRACKET = 57369
+const QUESTION = 57370
+const DOUBLEDOT = 57371
+const DOT = 57372
+const ADD = 57373
+const SUB = 57374
+const MUL = 57375
+const DIV = 57376
+const MOD = 57377
+const ADD_ASSIGN = 57378
+const SUB_ASSIGN = 57379
+const MUL_ASSIGN = 57380
+const DIV_ASSIGN = 57381
+const MOD_ASSIGN = 57382
+const AND_ASSIGN = 57383
+const OR_ASSIGN = 57384
+const XOR_ASSIGN = 57385
+const LSHIFT_ASSIGN = 57386
+const RSHIFT_ASSIGN = 57387
+const ASSIGN = 57388
+const INC = 57389
+const DEC = 57390
+const AND = 57391
+const OR = 57392
+const EQ = 57393
+const NOT_EQ = 57394
+const NOT = 57395
+const BIT_AND = 57396
+const BIT_OR = 57397
+const BIT_XOR = 57398
+const BIT_NOT = 57399
+const LSHIFT = 57400
+const RSHIFT = 57401
+const LT = 57402
+const GT = 57403
+const LTE = 57404
+const GTE = 57405
+const BREAK = 57406
+const CONTINUE = 57407
+const DATA = 57408
+const CONTRACT = 57409
+const IF = 57410
+const ELIF = 57411
+const ELSE = 57412
+const RETURN = 57413
+const WHILE = 57414
+const FUNC = 57415
+const FOR = 57416
+const IN = 57417
+const SWITCH = 57418
+const CASE = 57419
+const READ = 57420
+const DEFAULT = 57421
+const IMPORT = 57422
+const CONDITIONS = 57423
+const ACTION = 57424
+const TRY = 57425
+const CATCH = 57426
+const TYPE = 57427
+const STRUCT = 57428
+const T_INT = 57429
+const T_BOOL = 57430
+const T_STR = 57431
+const T_ARR = 57432
+const T_MAP = 57433
+const T_FLOAT = 57434
+const T_MONEY = 57435
+const T_OBJECT = 57436
+const T_BYTES = 57437
+const T_FILE = 57438
+const UNARYMINUS = 57439
+const UNARYNOT = 57440
 
 var yyToknames = [...]string{
 	"$end",
@@ -105,10 +137,13 @@ var yyToknames = [...]string{
 	"CALL",
 	"CALLCONTRACT",
 	"INDEX",
+	"STRUCTVALUE",
+	"FIELD",
 	"INT",
 	"FLOAT",
 	"STRING",
 	"QSTRING",
+	"ISTRING",
 	"TRUE",
 	"FALSE",
 	"NEWLINE",
@@ -134,12 +169,25 @@ var yyToknames = [...]string{
 	"MUL_ASSIGN",
 	"DIV_ASSIGN",
 	"MOD_ASSIGN",
+	"AND_ASSIGN",
+	"OR_ASSIGN",
+	"XOR_ASSIGN",
+	"LSHIFT_ASSIGN",
+	"RSHIFT_ASSIGN",
 	"ASSIGN",
+	"INC",
+	"DEC",
 	"AND",
 	"OR",
 	"EQ",
 	"NOT_EQ",
 	"NOT",
+	"BIT_AND",
+	"BIT_OR",
+	"BIT_XOR",
+	"BIT_NOT",
+	"LSHIFT",
+	"RSHIFT",
 	"LT",
 	"GT",
 	"LTE",
@@ -160,6 +208,13 @@ var yyToknames = [...]string{
 	"CASE",
 	"READ",
 	"DEFAULT",
+	"IMPORT",
+	"CONDITIONS",
+	"ACTION",
+	"TRY",
+	"CATCH",
+	"TYPE",
+	"STRUCT",
 	"T_INT",
 	"T_BOOL",
 	"T_STR",
@@ -173,6 +228,7 @@ var yyToknames = [...]string{
 	"UNARYMINUS",
 	"UNARYNOT",
 }
+
 var yyStatenames = [...]string{}
 
 const yyEofCode = 1
@@ -180,274 +236,450 @@ const yyErrCode = 2
 const yyInitialStackSize = 16
 
 //line yacctab:1
-var yyExca = [...]int{
+var yyExca = [...]int8{
 	-1, 1,
 	1, -1,
 	-2, 0,
+	-1, 36,
+	4, 13,
+	30, 13,
+	-2, 31,
+	-1, 37,
+	46, 32,
+	-2, 14,
 }
 
 const yyPrivate = 57344
 
-const yyLast = 1123
-
-var yyAct = [...]int{
-
-	80, 105, 81, 13, 56, 79, 21, 190, 108, 129,
-	86, 33, 185, 137, 187, 6, 74, 218, 20, 46,
-	256, 258, 2, 75, 11, 184, 76, 77, 124, 70,
-	176, 183, 141, 84, 35, 34, 36, 37, 38, 39,
-	40, 41, 42, 43, 71, 89, 90, 93, 102, 72,
-	70, 87, 163, 72, 159, 72, 136, 242, 104, 12,
-	103, 110, 252, 113, 114, 115, 116, 117, 118, 119,
-	120, 121, 122, 35, 34, 36, 37, 38, 39, 40,
-	41, 42, 43, 159, 123, 130, 269, 73, 112, 249,
-	143, 144, 145, 146, 147, 148, 149, 150, 151, 152,
-	153, 154, 155, 250, 140, 91, 92, 89, 90, 93,
-	72, 251, 237, 168, 165, 222, 94, 95, 96, 97,
-	166, 100, 101, 98, 99, 170, 14, 7, 162, 163,
-	171, 172, 177, 216, 161, 164, 179, 180, 217, 159,
-	91, 92, 89, 90, 93, 160, 87, 175, 234, 224,
-	182, 94, 95, 96, 97, 223, 100, 101, 98, 99,
-	133, 207, 131, 248, 206, 247, 200, 200, 208, 215,
-	205, 91, 92, 89, 90, 93, 20, 20, 167, 174,
-	213, 130, 173, 135, 214, 220, 110, 133, 131, 279,
-	158, 157, 225, 219, 221, 65, 66, 67, 68, 69,
-	64, 139, 227, 45, 226, 228, 230, 78, 133, 200,
-	235, 134, 231, 82, 131, 44, 238, 132, 240, 241,
-	239, 8, 3, 107, 125, 181, 243, 189, 200, 200,
-	106, 244, 245, 178, 188, 254, 83, 4, 5, 229,
-	109, 259, 1, 9, 20, 17, 263, 186, 20, 31,
-	142, 28, 29, 32, 266, 200, 267, 268, 265, 16,
-	15, 257, 236, 19, 20, 271, 272, 282, 20, 10,
-	128, 85, 212, 276, 0, 0, 20, 20, 0, 280,
-	31, 20, 28, 29, 32, 20, 0, 0, 0, 0,
-	0, 15, 0, 23, 24, 0, 0, 22, 281, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 31,
-	0, 28, 29, 32, 23, 24, 0, 0, 22, 0,
-	15, 25, 26, 27, 30, 0, 18, 278, 0, 0,
-	35, 34, 36, 37, 38, 39, 40, 41, 42, 43,
-	31, 0, 28, 29, 32, 0, 0, 0, 0, 0,
-	0, 15, 0, 23, 24, 0, 0, 22, 277, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 31,
-	0, 28, 29, 32, 23, 24, 0, 0, 22, 0,
-	15, 25, 26, 27, 30, 0, 18, 273, 0, 0,
-	35, 34, 36, 37, 38, 39, 40, 41, 42, 43,
-	31, 0, 28, 29, 32, 0, 0, 0, 0, 0,
-	0, 15, 0, 23, 24, 0, 0, 22, 270, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 31,
-	0, 28, 29, 32, 23, 24, 0, 0, 22, 0,
-	15, 25, 26, 27, 30, 0, 18, 264, 0, 0,
-	35, 34, 36, 37, 38, 39, 40, 41, 42, 43,
-	31, 0, 28, 29, 32, 0, 0, 0, 0, 0,
-	0, 15, 0, 23, 24, 0, 0, 22, 260, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 31,
-	0, 28, 29, 32, 23, 24, 0, 0, 22, 0,
-	15, 25, 26, 27, 30, 0, 18, 211, 0, 0,
-	35, 34, 36, 37, 38, 39, 40, 41, 42, 43,
-	31, 0, 28, 29, 32, 0, 0, 0, 0, 0,
-	0, 15, 0, 23, 24, 0, 0, 22, 210, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 31,
-	0, 28, 29, 32, 23, 24, 0, 0, 22, 0,
-	15, 25, 26, 27, 30, 0, 18, 0, 0, 0,
-	35, 34, 36, 37, 38, 39, 40, 41, 42, 43,
+const yyLast = 2337
+
+var yyAct = [...]int16{
+	116, 200, 288, 117, 63, 115, 154, 65, 151, 110,
+	163, 39, 64, 209, 320, 20, 6, 52, 19, 281,
+	323, 283, 214, 111, 202, 2, 112, 113, 370, 372,
+	203, 128, 129, 132, 21, 91, 327, 194, 216, 125,
+	93, 108, 376, 280, 284, 218, 267, 246, 257, 348,
+	364, 149, 133, 108, 146, 366, 136, 137, 365, 246,
+	285, 10, 92, 250, 109, 363, 148, 147, 356, 108,
+	150, 156, 108, 159, 160, 161, 162, 17, 213, 167,
+	168, 169, 170, 171, 172, 173, 174, 175, 176, 177,
+	108, 11, 391, 179, 180, 181, 182, 183, 184, 185,
+	186, 187, 188, 189, 190, 191, 192, 41, 40, 42,
+	43, 44, 45, 46, 47, 48, 49, 245, 206, 252,
+	193, 250, 246, 374, 244, 253, 375, 251, 247, 221,
+	222, 223, 224, 225, 226, 227, 228, 229, 230, 231,
+	232, 233, 234, 235, 236, 237, 238, 388, 202, 201,
+	242, 378, 344, 329, 203, 165, 271, 123, 202, 255,
+	243, 219, 349, 122, 203, 258, 121, 51, 206, 178,
+	7, 362, 158, 249, 204, 314, 265, 361, 206, 264,
+	248, 241, 341, 210, 211, 212, 204, 114, 206, 240,
+	204, 207, 331, 205, 330, 260, 317, 254, 156, 130,
+	131, 128, 129, 132, 262, 268, 208, 250, 404, 270,
+	377, 266, 278, 277, 275, 20, 20, 20, 19, 19,
+	19, 50, 133, 134, 135, 8, 136, 137, 3, 279,
+	307, 41, 40, 42, 43, 44, 45, 46, 47, 48,
+	49, 41, 40, 42, 43, 44, 45, 46, 47, 48,
+	49, 306, 305, 299, 299, 308, 153, 310, 261, 351,
+	263, 300, 300, 118, 195, 152, 20, 316, 20, 19,
+	276, 19, 41, 40, 42, 43, 44, 45, 46, 47,
+	48, 49, 156, 195, 269, 287, 166, 124, 328, 120,
+	332, 119, 325, 326, 286, 4, 5, 336, 155, 315,
+	201, 1, 334, 333, 9, 14, 282, 338, 220, 299,
+	342, 13, 335, 337, 371, 343, 199, 300, 347, 126,
+	217, 350, 18, 353, 354, 345, 313, 318, 355, 20,
+	319, 0, 19, 358, 359, 299, 299, 0, 0, 0,
+	324, 0, 368, 300, 300, 0, 0, 0, 0, 346,
+	0, 0, 0, 0, 201, 0, 0, 20, 0, 0,
+	19, 0, 20, 0, 0, 19, 0, 384, 385, 299,
+	386, 387, 0, 0, 0, 0, 0, 300, 20, 0,
+	0, 19, 0, 352, 0, 0, 0, 20, 0, 0,
+	19, 357, 0, 0, 0, 0, 0, 0, 20, 20,
+	20, 19, 19, 19, 20, 20, 373, 19, 19, 390,
+	20, 392, 0, 19, 0, 0, 0, 36, 382, 28,
+	29, 38, 0, 37, 0, 0, 0, 0, 0, 0,
+	0, 12, 0, 0, 0, 0, 0, 0, 408, 0,
+	393, 0, 394, 395, 0, 0, 0, 0, 0, 0,
+	399, 0, 36, 400, 28, 29, 38, 0, 37, 0,
+	405, 0, 0, 0, 0, 0, 12, 0, 0, 0,
+	0, 0, 0, 407, 0, 0, 0, 23, 24, 0,
+	0, 22, 0, 0, 25, 26, 27, 35, 0, 16,
+	0, 0, 0, 31, 32, 34, 33, 0, 30, 0,
+	41, 40, 42, 43, 44, 45, 46, 47, 48, 49,
+	0, 0, 23, 24, 0, 0, 22, 0, 0, 25,
+	26, 27, 35, 0, 16, 0, 0, 0, 31, 32,
+	34, 33, 0, 30, 0, 41, 40, 42, 43, 44,
+	45, 46, 47, 48, 49, 36, 0, 28, 29, 38,
+	0, 37, 0, 0, 0, 0, 0, 0, 0, 12,
+	0, 0, 0, 0, 0, 0, 406, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 36, 0,
+	28, 29, 38, 0, 37, 0, 0, 0, 0, 0,
+	0, 0, 12, 0, 0, 0, 0, 0, 0, 403,
+	0, 0, 0, 0, 0, 23, 24, 0, 0, 22,
+	202, 0, 25, 26, 27, 35, 203, 16, 0, 0,
+	0, 31, 32, 34, 33, 0, 30, 0, 41, 40,
+	42, 43, 44, 45, 46, 47, 48, 49, 23, 24,
+	0, 0, 22, 0, 0, 25, 26, 27, 35, 0,
+	16, 0, 0, 0, 31, 32, 34, 33, 0, 30,
+	0, 41, 40, 42, 43, 44, 45, 46, 47, 48,
+	49, 36, 0, 28, 29, 38, 0, 37, 0, 0,
+	0, 0, 0, 0, 0, 12, 0, 0, 0, 0,
+	0, 0, 402, 41, 40, 42, 43, 44, 45, 46,
+	47, 48, 49, 0, 36, 0, 28, 29, 38, 0,
+	37, 0, 0, 0, 0, 0, 0, 0, 12, 0,
+	0, 0, 0, 0, 0, 401, 0, 0, 0, 0,
+	0, 23, 24, 0, 0, 22, 0, 0, 25, 26,
+	27, 35, 0, 16, 0, 0, 0, 31, 32, 34,
+	33, 0, 30, 0, 41, 40, 42, 43, 44, 45,
+	46, 47, 48, 49, 23, 24, 0, 0, 22, 0,
+	0, 25, 26, 27, 35, 0, 16, 0, 0, 0,
+	31, 32, 34, 33, 0, 30, 0, 41, 40, 42,
+	43, 44, 45, 46, 47, 48, 49, 36, 0, 28,
+	29, 38, 0, 37, 0, 0, 0, 0, 0, 0,
+	0, 12, 0, 0, 0, 0, 0, 0, 396, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	36, 0, 28, 29, 38, 0, 37, 0, 0, 0,
+	0, 0, 0, 0, 12, 0, 0, 0, 0, 0,
+	0, 389, 0, 0, 0, 0, 0, 23, 24, 0,
+	0, 22, 0, 0, 25, 26, 27, 35, 0, 16,
+	0, 0, 0, 31, 32, 34, 33, 0, 30, 0,
+	41, 40, 42, 43, 44, 45, 46, 47, 48, 49,
+	23, 24, 0, 0, 22, 0, 0, 25, 26, 27,
+	35, 0, 16, 0, 0, 0, 31, 32, 34, 33,
+	0, 30, 0, 41, 40, 42, 43, 44, 45, 46,
+	47, 48, 49, 36, 0, 28, 29, 38, 0, 37,
+	0, 0, 0, 0, 0, 0, 0, 12, 0, 0,
+	0, 0, 0, 0, 383, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 36, 0, 28, 29,
+	38, 0, 37, 0, 0, 0, 0, 0, 0, 0,
+	12, 0, 0, 0, 0, 0, 0, 379, 0, 0,
 	0, 0, 0, 23, 24, 0, 0, 22, 0, 0,
-	25, 26, 27, 30, 0, 18, 0, 0, 0, 35,
-	34, 36, 37, 38, 39, 40, 41, 42, 43, 275,
-	0, 0, 0, 0, 0, 0, 91, 92, 89, 90,
-	93, 0, 0, 0, 0, 0, 0, 94, 95, 96,
-	97, 274, 100, 101, 98, 99, 0, 0, 0, 0,
-	91, 92, 89, 90, 93, 0, 0, 0, 0, 0,
-	0, 94, 95, 96, 97, 262, 100, 101, 98, 99,
-	0, 0, 91, 92, 89, 90, 93, 0, 0, 0,
-	0, 0, 0, 94, 95, 96, 97, 261, 100, 101,
-	98, 99, 0, 0, 91, 92, 89, 90, 93, 0,
-	0, 0, 255, 0, 0, 94, 95, 96, 97, 0,
-	100, 101, 98, 99, 91, 92, 89, 90, 93, 0,
-	0, 0, 0, 0, 0, 94, 95, 96, 97, 0,
-	100, 101, 98, 99, 58, 57, 54, 55, 32, 48,
-	49, 50, 51, 52, 53, 253, 0, 0, 47, 0,
-	59, 60, 0, 0, 246, 61, 0, 0, 0, 62,
-	0, 0, 0, 91, 92, 89, 90, 93, 0, 0,
-	0, 209, 0, 63, 94, 95, 96, 97, 0, 100,
-	101, 98, 99, 91, 92, 89, 90, 93, 0, 0,
-	0, 0, 0, 0, 94, 95, 96, 97, 0, 100,
-	101, 98, 99, 169, 0, 0, 0, 91, 92, 89,
-	90, 93, 0, 0, 0, 0, 0, 0, 94, 95,
-	96, 97, 156, 100, 101, 98, 99, 0, 0, 0,
-	0, 91, 92, 89, 90, 93, 0, 0, 0, 0,
-	0, 0, 94, 95, 96, 97, 0, 100, 101, 98,
-	99, 138, 0, 0, 0, 91, 92, 89, 90, 93,
-	0, 0, 0, 0, 0, 0, 94, 95, 96, 97,
-	127, 100, 101, 98, 99, 0, 0, 91, 92, 89,
-	90, 93, 0, 0, 0, 0, 0, 0, 94, 95,
-	96, 97, 126, 100, 101, 98, 99, 0, 0, 91,
-	92, 89, 90, 93, 0, 0, 88, 0, 0, 0,
-	94, 95, 96, 97, 0, 100, 101, 98, 99, 91,
-	92, 89, 90, 93, 0, 0, 0, 0, 0, 0,
-	94, 95, 96, 97, 0, 100, 101, 98, 99, 58,
-	57, 54, 55, 32, 48, 49, 50, 51, 52, 53,
-	0, 0, 0, 47, 0, 59, 60, 0, 0, 0,
-	61, 0, 0, 0, 62, 58, 57, 54, 55, 32,
-	48, 49, 111, 51, 52, 53, 0, 0, 63, 47,
-	0, 59, 60, 0, 0, 0, 61, 0, 0, 0,
-	62, 0, 0, 91, 92, 89, 90, 93, 0, 0,
-	0, 0, 0, 0, 63, 95, 96, 97, 0, 100,
-	101, 98, 99, 91, 92, 89, 90, 93, 0, 0,
-	0, 0, 0, 0, 0, 0, 96, 97, 0, 100,
-	101, 98, 99, 202, 201, 198, 199, 32, 192, 193,
-	194, 195, 196, 197, 0, 0, 0, 191, 0, 0,
-	203, 0, 204, 233, 201, 198, 199, 32, 192, 193,
-	232, 195, 196, 197, 0, 0, 0, 191, 0, 0,
-	203, 0, 204,
+	25, 26, 27, 35, 0, 16, 0, 0, 0, 31,
+	32, 34, 33, 0, 30, 0, 41, 40, 42, 43,
+	44, 45, 46, 47, 48, 49, 23, 24, 0, 0,
+	22, 0, 0, 25, 26, 27, 35, 0, 16, 0,
+	0, 0, 31, 32, 34, 33, 0, 30, 0, 41,
+	40, 42, 43, 44, 45, 46, 47, 48, 49, 36,
+	0, 28, 29, 38, 0, 37, 0, 0, 0, 0,
+	0, 0, 0, 12, 0, 0, 0, 0, 0, 0,
+	312, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 36, 0, 28, 29, 38, 0, 37, 0,
+	0, 0, 0, 0, 0, 0, 12, 0, 0, 0,
+	0, 0, 0, 311, 0, 0, 0, 0, 0, 23,
+	24, 0, 0, 22, 0, 0, 25, 26, 27, 35,
+	0, 16, 0, 0, 0, 31, 32, 34, 33, 0,
+	30, 0, 41, 40, 42, 43, 44, 45, 46, 47,
+	48, 49, 23, 24, 0, 0, 22, 0, 0, 25,
+	26, 27, 35, 0, 16, 0, 0, 0, 31, 32,
+	34, 33, 0, 30, 0, 41, 40, 42, 43, 44,
+	45, 46, 47, 48, 49, 36, 0, 28, 29, 38,
+	0, 37, 0, 0, 0, 0, 0, 0, 0, 12,
+	0, 0, 0, 0, 0, 0, 274, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 36, 0,
+	28, 29, 38, 0, 37, 0, 0, 0, 0, 0,
+	0, 0, 12, 0, 0, 0, 0, 0, 0, 273,
+	0, 0, 0, 0, 0, 23, 24, 0, 0, 22,
+	0, 0, 25, 26, 27, 35, 0, 16, 0, 0,
+	0, 31, 32, 34, 33, 0, 30, 0, 41, 40,
+	42, 43, 44, 45, 46, 47, 48, 49, 23, 24,
+	0, 0, 22, 0, 0, 25, 26, 27, 35, 0,
+	16, 0, 0, 0, 31, 32, 34, 33, 0, 30,
+	0, 41, 40, 42, 43, 44, 45, 46, 47, 48,
+	49, 36, 0, 28, 29, 38, 0, 37, 0, 0,
+	0, 0, 0, 0, 0, 12, 0, 0, 0, 321,
+	0, 0, 272, 0, 322, 0, 130, 131, 128, 129,
+	132, 0, 0, 0, 36, 0, 28, 29, 38, 0,
+	37, 0, 0, 0, 138, 139, 140, 141, 12, 133,
+	134, 135, 0, 136, 137, 144, 145, 142, 143, 0,
+	0, 23, 24, 0, 0, 22, 0, 0, 25, 26,
+	27, 35, 0, 16, 0, 0, 0, 31, 32, 34,
+	33, 0, 30, 0, 41, 40, 42, 43, 44, 45,
+	46, 47, 48, 49, 23, 24, 15, 0, 22, 0,
+	0, 25, 26, 27, 35, 0, 16, 0, 0, 0,
+	31, 32, 34, 33, 0, 30, 0, 41, 40, 42,
+	43, 44, 45, 46, 47, 48, 49, 36, 0, 28,
+	29, 38, 256, 37, 0, 0, 0, 0, 0, 259,
+	0, 12, 0, 130, 131, 128, 129, 132, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 138, 139, 140, 141, 0, 133, 134, 135, 0,
+	136, 137, 144, 145, 142, 143, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 23, 24, 0,
+	0, 22, 0, 0, 25, 26, 27, 35, 0, 16,
+	0, 0, 0, 31, 32, 34, 33, 0, 30, 0,
+	41, 40, 42, 43, 44, 45, 46, 47, 48, 49,
+	256, 0, 0, 0, 0, 0, 0, 215, 0, 0,
+	0, 130, 131, 128, 129, 132, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 138,
+	139, 140, 141, 0, 133, 134, 135, 398, 136, 137,
+	144, 145, 142, 143, 130, 131, 128, 129, 132, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 139, 140, 141, 0, 133, 134, 135,
+	397, 136, 137, 144, 145, 142, 143, 0, 0, 130,
+	131, 128, 129, 132, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 139, 140,
+	141, 0, 133, 134, 135, 381, 136, 137, 144, 145,
+	142, 143, 130, 131, 128, 129, 132, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 139, 140, 141, 0, 133, 134, 135, 380, 136,
+	137, 144, 145, 142, 143, 130, 131, 128, 129, 132,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 138, 139, 140, 141, 369, 133, 134,
+	135, 0, 136, 137, 144, 145, 142, 143, 0, 130,
+	131, 128, 129, 132, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 138, 139, 140,
+	141, 0, 133, 134, 135, 360, 136, 137, 144, 145,
+	142, 143, 0, 0, 130, 131, 128, 129, 132, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 139, 140, 141, 309, 133, 134, 135,
+	0, 136, 137, 144, 145, 142, 143, 0, 130, 131,
+	128, 129, 132, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 138, 139, 140, 141,
+	0, 133, 134, 135, 0, 136, 137, 144, 145, 142,
+	143, 259, 0, 0, 0, 130, 131, 128, 129, 132,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 138, 139, 140, 141, 0, 133, 134,
+	135, 239, 136, 137, 144, 145, 142, 143, 0, 0,
+	130, 131, 128, 129, 132, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 139,
+	140, 141, 0, 133, 134, 135, 0, 136, 137, 144,
+	145, 142, 143, 215, 0, 0, 0, 130, 131, 128,
+	129, 132, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 139, 140, 141, 0,
+	133, 134, 135, 198, 136, 137, 144, 145, 142, 143,
+	130, 131, 128, 129, 132, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 138, 139,
+	140, 141, 197, 133, 134, 135, 0, 136, 137, 144,
+	145, 142, 143, 0, 130, 131, 128, 129, 132, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 138, 139, 140, 141, 0, 133, 134, 135,
+	196, 136, 137, 144, 145, 142, 143, 130, 131, 128,
+	129, 132, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 138, 139, 140, 141, 127,
+	133, 134, 135, 0, 136, 137, 144, 145, 142, 143,
+	0, 0, 130, 131, 128, 129, 132, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	138, 139, 140, 141, 0, 133, 134, 135, 0, 136,
+	137, 144, 145, 142, 143, 130, 131, 128, 129, 132,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 138, 139, 140, 141, 77, 133, 134,
+	135, 0, 136, 137, 144, 145, 142, 143, 130, 131,
+	128, 129, 132, 0, 79, 80, 81, 82, 83, 84,
+	85, 86, 87, 88, 78, 89, 90, 139, 140, 141,
+	0, 133, 134, 135, 0, 136, 137, 144, 145, 142,
+	143, 68, 67, 61, 62, 75, 66, 76, 54, 55,
+	56, 57, 58, 59, 60, 367, 0, 0, 53, 0,
+	69, 70, 0, 0, 0, 71, 0, 0, 0, 72,
+	0, 0, 130, 131, 128, 129, 132, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	73, 0, 140, 141, 74, 133, 134, 135, 0, 136,
+	137, 144, 145, 142, 143, 68, 67, 61, 62, 75,
+	66, 76, 54, 55, 56, 57, 58, 59, 60, 0,
+	0, 164, 53, 0, 69, 70, 0, 0, 0, 71,
+	0, 0, 0, 72, 0, 0, 68, 67, 61, 62,
+	75, 66, 76, 54, 55, 56, 57, 58, 59, 60,
+	0, 0, 0, 53, 73, 69, 70, 0, 74, 0,
+	71, 0, 0, 0, 72, 0, 0, 68, 67, 61,
+	62, 75, 66, 76, 54, 55, 157, 57, 58, 59,
+	60, 0, 0, 0, 53, 73, 69, 70, 94, 74,
+	0, 71, 0, 0, 0, 72, 0, 0, 96, 97,
+	98, 99, 100, 101, 102, 103, 104, 105, 95, 106,
+	107, 0, 0, 0, 0, 0, 73, 0, 0, 0,
+	74, 302, 301, 297, 298, 75, 0, 0, 290, 291,
+	292, 293, 294, 295, 296, 0, 0, 0, 289, 0,
+	0, 303, 0, 304, 340, 301, 297, 298, 75, 0,
+	0, 290, 291, 339, 293, 294, 295, 296, 0, 0,
+	0, 289, 0, 0, 303, 0, 304,
 }
-var yyPact = [...]int{
-
-	-29, 207, 233, -1000, -47, 106, -1000, 206, -26, 37,
-	-1000, 105, -1000, 595, 200, -1000, -1000, 188, 985, 162,
-	6, 83, 985, -1000, -1000, 985, 985, 201, 985, 209,
-	232, -1000, 985, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
-	-1000, -1000, -1000, -1000, -30, -1000, 941, 985, -1000, -1000,
-	-1000, -1000, -1000, -1000, 985, 209, 27, -1000, -1000, 219,
-	1011, 70, 985, 985, 985, 985, 985, 985, 985, 985,
-	985, 985, -30, -10, 220, 921, 77, 899, -30, 198,
-	77, 192, 166, -3, 877, 186, -1000, 28, -1000, 985,
-	985, 985, 985, 985, 985, 985, 985, 985, 985, 985,
-	985, 985, 853, 172, 171, 123, 117, 111, 113, 98,
-	77, 161, 985, -1000, -1000, 77, 77, 77, 77, 77,
-	77, 829, 77, -1000, 985, -1000, -1000, -1000, 163, -1000,
-	26, 985, -1000, 229, -1000, 985, 985, 221, -1000, 9,
-	220, -13, -49, -1000, -1000, 15, 15, -1000, 1015, 1035,
-	143, 143, 143, 143, 143, 143, -1000, -1000, -1000, 223,
-	-1000, 1079, 1079, 985, -1000, 150, -1000, 985, 805, -1000,
-	77, 556, 525, -30, -30, 220, -1000, 77, 152, 77,
-	112, -42, -1000, 178, 985, 985, -1000, 94, 138, 132,
-	-1000, 985, -1000, -1000, -1000, -1000, -1000, -1000, 985, 209,
-	27, -1000, -1000, 219, 1099, -1000, 77, 131, 77, 985,
-	-1000, -1000, 91, 22, -1000, 985, -1000, 985, 985, -1000,
-	77, 36, -1000, 1079, 1079, 785, 146, 144, 67, 87,
-	38, -1000, 117, 111, 780, 736, -33, -1000, 77, 486,
-	716, 694, -1000, 455, -1000, -1000, -1000, -1000, -1000, -1000,
-	1079, -1000, -1000, 985, 77, 985, 985, -1000, 65, 416,
-	-1000, -1000, -1000, 385, -1000, -1000, 77, 672, 648, -1000,
-	-1000, 346, 315, 174, -1000, -1000, 276, -1000, -1000, -1000,
-	245, -1000, -1000,
+
+var yyPact = [...]int16{
+	-42, 210, 291, -1000, -62, 146, -1000, 207, -1000, 66,
+	1330, -1000, -1000, -1000, 203, 143, 2202, 2048, 16, -6,
+	2232, 60, 2202, -1000, -1000, 2202, 2202, 181, 2202, 259,
+	287, 285, 142, 139, 133, 283, -1000, -1000, 2202, -1000,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, -1000, 1981, 2202, -1000, -1000, -1000, -1000, -1000, -1000,
+	-1000, 2202, 259, 25, -1000, -1000, 259, -1000, -1000, 252,
+	2233, 151, 2202, 2202, 2202, 2171, -1000, 282, 2202, 2202,
+	2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202, -1000,
+	-1000, 282, 2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202,
+	2202, 2202, 2202, 2202, 2202, 2202, -1000, -1000, 185, -9,
+	260, 1946, 1913, 1879, 606, 171, 2014, 169, 186, -73,
+	-1000, -1000, -1000, -1000, 3, 1846, 20, -1000, 2202, 2202,
+	2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202, 2202,
+	2202, 2202, 2202, 2202, 2202, 2202, 1809, 167, 159, 2171,
+	99, 103, 160, 153, 102, 100, 2014, 177, 2202, -1000,
+	-1000, -1000, 1500, 21, 2202, -1000, -1000, 2014, 2014, 2014,
+	2014, 2014, 2014, 2014, 2014, 2014, 2014, 2014, -1000, 2014,
+	2014, 1774, 2014, 2014, 2014, 2014, 2014, 2014, 2014, 2014,
+	2014, 2014, 2014, -1000, 2202, -1000, -1000, 2202, -1000, 157,
+	-1000, 42, -1000, -1000, 2202, -1000, 280, -1000, 2202, 132,
+	1297, 1204, 1171, 2202, 266, -1000, -1000, 195, 194, 39,
+	-58, -1000, -1000, -2, -2, -1000, -1000, -2, -2, -1000,
+	-1000, 2047, 2111, 168, 168, 168, 168, 168, 168, -1000,
+	-1000, -1000, 1412, 17, -1000, 35, 281, -1000, 2287, 2287,
+	2202, -1000, 217, -1000, 2202, 1737, 2202, -1000, 2014, -1000,
+	2014, 1078, 188, 1045, 154, 606, 260, -1000, 2014, 176,
+	2014, -1000, -1000, -70, -1000, 1295, -55, -1000, -1000, 279,
+	-10, 2202, -1000, 129, -1000, -1000, 174, 172, -1000, 2202,
+	-1000, -1000, -1000, -1000, -1000, -1000, -1000, 2202, 259, 25,
+	-1000, -1000, -1000, 252, 2310, -1000, 2014, 162, 2014, 2202,
+	2014, -1000, -1000, 128, 606, 11, -1000, 2202, 24, 144,
+	255, -1000, 2202, 2202, 1423, -1000, -1000, 2202, 44, -1000,
+	2287, 2287, 1703, 155, 149, 40, 31, 28, -1000, 160,
+	153, 2107, 1668, -41, -1000, 104, 23, 2014, -1000, -1000,
+	192, 127, 952, 1634, 1601, 2014, -1000, 919, -1000, -1000,
+	-1000, -1000, -1000, -1000, 2287, -1000, -1000, 2202, 2014, 2202,
+	2202, -1000, 123, 826, 606, 68, 606, -1000, -1000, -1000,
+	-1000, -1000, 793, -1000, -1000, 2014, 1568, 1533, -1000, -1000,
+	11, -1000, 11, 700, 667, 574, 190, -1000, -1000, 541,
+	448, -1000, -1000, -1000, -1000, 413, -1000, -1000, -1000,
 }
-var yyPgo = [...]int{
 
-	0, 11, 6, 272, 16, 10, 271, 9, 270, 269,
-	5, 263, 0, 262, 261, 259, 250, 247, 245, 3,
-	2, 243, 242, 4, 8, 240, 7, 1, 239, 238,
+var yyPgo = [...]int16{
+	0, 11, 34, 330, 327, 7, 326, 325, 322, 9,
+	320, 319, 1, 316, 5, 77, 0, 315, 314, 311,
+	308, 306, 305, 61, 3, 304, 301, 4, 12, 10,
+	6, 298, 2, 8, 297, 296,
 }
-var yyR1 = [...]int{
 
+var yyR1 = [...]int8{
 	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 2, 2, 3, 3, 19, 19, 19, 19, 10,
-	10, 10, 20, 20, 20, 11, 23, 23, 14, 14,
-	13, 13, 16, 16, 17, 17, 15, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 18, 18,
-	18, 18, 18, 18, 18, 18, 18, 18, 24, 24,
-	25, 25, 25, 27, 27, 27, 27, 28, 28, 26,
-	26, 26, 26, 26, 26, 26, 26, 26, 26, 26,
-	26, 26, 26, 26, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 12, 12, 12, 12, 12,
-	12, 12, 12, 12, 12, 4, 4, 7, 8, 8,
-	8, 5, 5, 6, 6, 9, 9, 21, 29, 29,
-	22, 22,
+	1, 2, 2, 2, 2, 6, 6, 7, 7, 8,
+	8, 23, 23, 23, 23, 14, 14, 14, 24, 24,
+	24, 15, 5, 27, 27, 29, 29, 29, 29, 28,
+	28, 18, 18, 17, 17, 20, 20, 21, 21, 19,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 22,
+	22, 22, 22, 22, 22, 22, 22, 22, 22, 30,
+	30, 31, 31, 31, 33, 33, 33, 33, 34, 34,
+	32, 32, 32, 32, 32, 32, 32, 32, 32, 32,
+	32, 32, 32, 32, 32, 32, 32, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 16,
+	16, 16, 16, 16, 16, 16, 16, 16, 16, 9,
+	9, 12, 3, 3, 3, 4, 4, 13, 13, 13,
+	10, 10, 10, 10, 11, 11, 11, 25, 25, 35,
+	35, 26, 26,
 }
-var yyR2 = [...]int{
 
+var yyR2 = [...]int8{
 	0, 1, 1, 1, 1, 1, 1, 1, 1, 1,
-	1, 1, 3, 0, 1, 0, 2, 2, 3, 0,
-	1, 3, 0, 3, 5, 1, 3, 4, 0, 4,
-	0, 6, 0, 7, 0, 4, 5, 3, 3, 3,
-	3, 3, 3, 3, 4, 2, 7, 1, 1, 1,
-	2, 5, 8, 3, 3, 7, 9, 9, 1, 3,
-	3, 6, 5, 3, 3, 5, 5, 1, 3, 3,
-	1, 1, 1, 1, 1, 1, 3, 3, 1, 1,
-	1, 3, 3, 3, 3, 1, 1, 1, 1, 1,
-	1, 3, 3, 1, 1, 1, 3, 3, 3, 8,
+	1, 1, 3, 1, 1, 0, 1, 3, 3, 3,
+	3, 0, 2, 2, 3, 0, 1, 3, 0, 3,
+	5, 1, 1, 3, 4, 3, 2, 2, 1, 3,
+	4, 0, 4, 0, 6, 0, 7, 0, 4, 5,
+	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
+	3, 2, 2, 3, 3, 3, 3, 3, 3, 3,
+	3, 3, 3, 3, 3, 3, 2, 2, 4, 2,
+	7, 1, 1, 1, 2, 4, 5, 8, 10, 3,
+	3, 6, 2, 4, 9, 4, 7, 9, 9, 1,
+	3, 3, 6, 5, 3, 3, 5, 5, 1, 3,
+	3, 1, 1, 1, 1, 1, 1, 1, 3, 3,
+	1, 1, 1, 1, 3, 3, 3, 3, 1, 1,
+	1, 1, 1, 1, 1, 3, 3, 1, 1, 1,
+	3, 4, 1, 1, 3, 3, 3, 8, 3, 3,
 	3, 3, 3, 3, 3, 3, 3, 3, 3, 3,
-	3, 3, 3, 2, 2, 1, 2, 2, 0, 1,
-	3, 2, 4, 1, 3, 0, 7, 2, 0, 1,
-	7, 2,
+	3, 3, 3, 3, 3, 3, 2, 2, 2, 1,
+	2, 2, 0, 2, 3, 1, 2, 0, 1, 3,
+	2, 3, 3, 4, 0, 2, 3, 1, 7, 0,
+	1, 7, 2,
 }
-var yyChk = [...]int{
-
-	-1000, -22, 51, 15, 4, -29, 62, 21, 15, -21,
-	-9, 50, 22, -19, 21, 15, -15, -18, 60, -11,
-	-23, -2, 52, 48, 49, 55, 56, 57, 6, 7,
-	58, 4, 8, -1, 65, 64, 66, 67, 68, 69,
-	70, 71, 72, 73, 15, 15, -12, 18, 9, 10,
-	11, 12, 13, 14, 6, 7, -23, 5, 4, 20,
-	21, 25, 29, 43, 38, 33, 34, 35, 36, 37,
-	23, 38, 27, 4, -4, -12, -12, -12, 6, -10,
-	-12, -20, 4, 4, -12, -6, -5, -2, 15, 30,
-	31, 28, 29, 32, 39, 40, 41, 42, 46, 47,
-	44, 45, -12, -10, -20, -27, 11, 4, -24, -25,
-	-12, 11, 18, -12, -12, -12, -12, -12, -12, -12,
-	-12, -12, -12, -1, 38, 4, 21, 21, -8, -7,
-	-2, 16, 19, 16, 19, 17, 59, 16, 24, 15,
-	-4, 4, -16, -12, -12, -12, -12, -12, -12, -12,
-	-12, -12, -12, -12, -12, -12, 19, 19, 19, 16,
-	22, 17, 17, 16, 22, 16, 22, 17, -12, 24,
-	-12, -19, -19, 19, 16, -4, 4, -12, 4, -12,
-	-12, 4, -5, 22, 38, 61, -17, 63, 11, 4,
-	-26, 18, 9, 10, 11, 12, 13, 14, 6, 7,
-	-23, 5, 4, 21, 23, -26, -12, 11, -12, 16,
-	22, 22, -3, -2, -7, 17, 21, 26, 59, 15,
-	-12, -24, 21, 17, 17, -12, -10, -20, -27, -28,
-	-27, -26, 11, 4, 17, -12, -13, 21, -12, -19,
-	-12, -12, 21, -19, -26, -26, 19, 19, 19, 22,
-	16, 24, 24, 15, -12, 16, 53, -14, 54, -19,
-	22, 21, 21, -19, 22, -26, -12, -12, -12, 21,
-	22, -19, -19, 22, 19, 21, -19, 22, 22, 15,
-	-19, 22, 22,
+
+var yyChk = [...]int16{
+	-1000, -26, 67, 18, 4, -35, 78, 24, 18, -25,
+	-23, 25, 18, -19, -22, 66, 76, -15, -8, -5,
+	-27, -2, 68, 64, 65, 71, 72, 73, 6, 7,
+	85, 80, 81, 83, 82, 74, 4, 10, 8, -1,
+	88, 87, 89, 90, 91, 92, 93, 94, 95, 96,
+	18, 24, -16, 21, 11, 12, 13, 14, 15, 16,
+	17, 6, 7, -27, -28, -5, 9, 5, 4, 23,
+	24, 28, 32, 53, 57, 8, 10, 19, 46, 36,
+	37, 38, 39, 40, 41, 42, 43, 44, 45, 47,
+	48, 19, 46, 46, 26, 46, 36, 37, 38, 39,
+	40, 41, 42, 43, 44, 45, 47, 48, 30, 4,
+	-9, -16, -16, -16, 6, -14, -16, -24, 4, 4,
+	4, 24, 24, 24, 4, -16, -11, 18, 33, 34,
+	31, 32, 35, 54, 55, 56, 58, 59, 49, 50,
+	51, 52, 62, 63, 60, 61, -16, -14, -24, 26,
+	-24, -33, 13, 4, -30, -31, -16, 13, 21, -16,
+	-16, -16, -16, -29, 20, -15, 4, -16, -16, -16,
+	-16, -16, -16, -16, -16, -16, -16, -16, -15, -16,
+	-16, -16, -16, -16, -16, -16, -16, -16, -16, -16,
+	-16, -16, -16, -1, 46, 4, 24, 19, 24, -13,
+	-12, -2, 4, 10, 19, 22, 19, 22, 20, 86,
+	-23, -23, -23, 75, 19, 27, 18, -10, 25, -2,
+	-20, -16, -16, -16, -16, -16, -16, -16, -16, -16,
+	-16, -16, -16, -16, -16, -16, -16, -16, -16, 22,
+	22, 22, -16, -29, 25, 18, 19, 25, 20, 20,
+	19, 25, 19, 25, 20, -16, 20, 27, -16, 27,
+	-16, -23, -30, -23, 22, 19, -9, 4, -16, 4,
+	-16, 24, 25, 25, 25, -16, 4, 18, 18, -9,
+	4, 77, -21, 79, 27, 25, 13, 4, -32, 21,
+	11, 12, 13, 14, 15, 16, 17, 6, 7, -27,
+	-28, 5, 4, 24, 26, -32, -16, 13, -16, 19,
+	-16, 25, 25, -6, 21, -2, -12, 20, -4, -3,
+	84, 24, 29, 75, -23, 13, 14, 46, -30, 24,
+	20, 20, -16, -14, -24, -33, -34, -33, -32, 13,
+	4, 20, -16, -17, 24, -7, -2, -16, 25, 18,
+	-12, 4, -23, -16, -16, -16, 24, -23, -32, -32,
+	22, 22, 22, 25, 19, 27, 27, 18, -16, 19,
+	69, -18, 70, -23, 19, 22, 19, 18, 24, 25,
+	24, 24, -23, 25, -32, -16, -16, -16, 24, 25,
+	-2, 24, -2, -23, -23, -23, 25, 22, 24, -23,
+	-23, 25, 25, 25, 18, -23, 25, 25, 25,
 }
-var yyDef = [...]int{
-
-	0, -2, 0, 131, 128, 0, 129, 0, 125, 0,
-	15, 0, 130, 127, 0, 16, 17, 0, 0, 0,
-	0, 0, 0, 47, 48, 49, 0, 0, 19, 22,
-	0, 25, 0, 11, 1, 2, 3, 4, 5, 6,
-	7, 8, 9, 10, 0, 18, 0, 0, 85, 86,
-	87, 88, 89, 90, 19, 22, 93, 94, 95, 0,
-	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	0, 0, 0, 115, 45, 0, 50, 0, 118, 0,
-	20, 0, 0, 0, 0, 0, 123, 0, 32, 0,
+
+var yyDef = [...]int16{
+	0, -2, 0, 192, 189, 0, 190, 0, 21, 0,
+	187, 191, 22, 23, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 81, 82, 83, 0, 0, 25, 28,
+	0, 0, 0, 0, 0, 0, -2, -2, 0, 11,
+	1, 2, 3, 4, 5, 6, 7, 8, 9, 10,
+	24, 184, 0, 0, 128, 129, 130, 131, 132, 133,
+	134, 25, 28, 137, 138, 139, 28, 142, 143, 0,
+	0, 0, 0, 0, 0, 0, 32, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 0, 0, 0, 61,
+	62, 0, 0, 0, 0, 0, 0, 0, 0, 0,
+	0, 0, 0, 0, 0, 0, 76, 77, 0, 169,
+	79, 0, 84, 0, 177, 0, 26, 0, 0, 0,
+	92, 21, 21, 21, 0, 0, 0, 45, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
 	0, 0, 0, 0, 0, 0, 0, 0, 0, 0,
-	58, 87, 0, 113, 114, 37, 38, 39, 40, 41,
-	42, 0, 43, 12, 0, 116, 15, 15, 0, 119,
-	0, 0, 53, 0, 54, 0, 0, 0, 26, 0,
-	121, 115, 34, 100, 101, 102, 103, 104, 105, 106,
-	107, 108, 109, 110, 111, 112, 84, 91, 92, 0,
-	96, 0, 0, 0, 97, 0, 98, 0, 0, 27,
-	44, 0, 0, 13, 0, 117, 115, 21, 0, 23,
-	0, 0, 124, 0, 0, 0, 36, 0, 0, 0,
-	63, 0, 70, 71, 72, 73, 74, 75, 19, 22,
-	78, 79, 80, 0, 0, 64, 59, 0, 60, 0,
-	30, 51, 0, 14, 120, 0, 15, 0, 0, 126,
-	122, 0, 15, 0, 0, 0, 0, 0, 0, 0,
-	0, 67, 72, 80, 0, 0, 28, 15, 24, 0,
-	0, 0, 15, 0, 65, 66, 69, 76, 77, 81,
-	0, 82, 83, 0, 62, 0, 0, 46, 0, 0,
-	55, 15, 15, 0, 35, 68, 61, 0, 0, 15,
-	52, 0, 0, 0, 99, 15, 0, 57, 56, 33,
-	0, 29, 31,
+	0, 0, 0, 0, 0, 0, 99, 130, 0, 166,
+	167, 168, 0, 0, 38, 19, 31, 50, 51, 52,
+	53, 54, 55, 56, 57, 58, 59, 60, 20, 63,
+	64, 0, 65, 66, 67, 68, 69, 70, 71, 72,
+	73, 74, 75, 12, 0, 170, 21, 0, 21, 0,
+	178, 0, 13, 14, 0, 89, 0, 90, 0, 0,
+	0, 0, 0, 0, 0, 33, 185, 0, 0, 0,
+	47, 148, 149, 150, 151, 152, 153, 154, 155, 156,
+	157, 158, 159, 160, 161, 162, 163, 164, 165, 127,
+	135, 136, 0, 0, 140, 0, 0, 144, 0, 0,
+	0, 145, 0, 146, 0, 0, 36, 39, 37, 34,
+	78, 0, 85, 0, 15, 0, 171, 169, 27, 0,
+	29, 172, 93, 0, 95, 0, 0, 186, 21, 180,
+	169, 0, 49, 0, 40, 141, 0, 0, 104, 0,
+	111, 112, 113, 114, 115, 116, 117, 25, 28, 120,
+	121, 122, 123, 0, 0, 105, 100, 0, 101, 0,
+	35, 43, 86, 0, 0, 16, 179, 0, 0, 175,
+	0, 21, 0, 0, 188, 181, 182, 0, 0, 21,
+	0, 0, 0, 0, 0, 0, 0, 0, 108, 113,
+	123, 0, 0, 41, 21, 0, 0, 30, 91, 173,
+	176, 0, 0, 0, 0, 183, 21, 0, 106, 107,
+	110, 118, 119, 124, 0, 125, 126, 0, 103, 0,
+	0, 80, 0, 0, 0, 0, 0, 174, 21, 96,
+	21, 21, 0, 48, 109, 102, 0, 0, 21, 87,
+	18, 21, 17, 0, 0, 0, 0, 147, 21, 0,
+	0, 94, 98, 97, 46, 0, 42, 88, 44,
 }
-var yyTok1 = [...]int{
 
+var yyTok1 = [...]int8{
 	1,
 }
-var yyTok2 = [...]int{
 
+var yyTok2 = [...]int8{
 	2, 3, 4, 5, 6, 7, 8, 9, 10, 11,
 	12, 13, 14, 15, 16, 17, 18, 19, 20, 21,
 	22, 23, 24, 25, 26, 27, 28, 29, 30, 31,
@@ -455,9 +687,12 @@ var yyTok2 = [...]int{
 	42, 43, 44, 45, 46, 47, 48, 49, 50, 51,
 	52, 53, 54, 55, 56, 57, 58, 59, 60, 61,
 	62, 63, 64, 65, 66, 67, 68, 69, 70, 71,
-	72, 73, 74, 75,
+	72, 73, 74, 75, 76, 77, 78, 79, 80, 81,
+	82, 83, 84, 85, 86, 87, 88, 89, 90, 91,
+	92, 93, 94, 95, 96, 97, 98,
 }
-var yyTok3 = [...]int{
+
+var yyTok3 = [...]int8{
 	0,
 }
 
@@ -539,9 +774,9 @@ func yyErrorMessage(state, lookAhead int) string {
 	expected := make([]int, 0, 4)
 
 	// Look for shiftable tokens.
-	base := yyPact[state]
+	base := int(yyPact[state])
 	for tok := TOKSTART; tok-1 < len(yyToknames); tok++ {
-		if n := base + tok; n >= 0 && n < yyLast && yyChk[yyAct[n]] == tok {
+		if n := base + tok; n >= 0 && n < yyLast && int(yyChk[int(yyAct[n])]) == tok {
 			if len(expected) == cap(expected) {
 				return res
 			}
@@ -551,13 +786,13 @@ func yyErrorMessage(state, lookAhead int) string {
 
 	if yyDef[state] == -2 {
 		i := 0
-		for yyExca[i] != -1 || yyExca[i+1] != state {
+		for yyExca[i] != -1 || int(yyExca[i+1]) != state {
 			i += 2
 		}
 
 		// Look for tokens that we accept or reduce.
 		for i += 2; yyExca[i] >= 0; i += 2 {
-			tok := yyExca[i]
+			tok := int(yyExca[i])
 			if tok < TOKSTART || yyExca[i+1] == 0 {
 				continue
 			}
@@ -588,30 +823,30 @@ func yylex1(lex yyLexer, lval *yySymType) (char, token int) {
 	token = 0
 	char = lex.Lex(lval)
 	if char <= 0 {
-		token = yyTok1[0]
+		token = int(yyTok1[0])
 		goto out
 	}
 	if char < len(yyTok1) {
-		token = yyTok1[char]
+		token = int(yyTok1[char])
 		goto out
 	}
 	if char >= yyPrivate {
 		if char < yyPrivate+len(yyTok2) {
-			token = yyTok2[char-yyPrivate]
+			token = int(yyTok2[char-yyPrivate])
 			goto out
 		}
 	}
 	for i := 0; i < len(yyTok3); i += 2 {
-		token = yyTok3[i+0]
+		token = int(yyTok3[i+0])
 		if token == char {
-			token = yyTok3[i+1]
+			token = int(yyTok3[i+1])
 			goto out
 		}
 	}
 
 out:
 	if token == 0 {
-		token = yyTok2[1] /* unknown char */
+		token = int(yyTok2[1]) /* unknown char */
 	}
 	if yyDebug >= 3 {
 		__yyfmt__.Printf("lex %s(%d)\n", yyTokname(token), uint(char))
@@ -666,7 +901,7 @@ yystack:
 	yyS[yyp].yys = yystate
 
 yynewstate:
-	yyn = yyPact[yystate]
+	yyn = int(yyPact[yystate])
 	if yyn <= yyFlag {
 		goto yydefault /* simple state */
 	}
@@ -677,8 +912,8 @@ yynewstate:
 	if yyn < 0 || yyn >= yyLast {
 		goto yydefault
 	}
-	yyn = yyAct[yyn]
-	if yyChk[yyn] == yytoken { /* valid shift */
+	yyn = int(yyAct[yyn])
+	if int(yyChk[yyn]) == yytoken { /* valid shift */
 		yyrcvr.char = -1
 		yytoken = -1
 		yyVAL = yyrcvr.lval
@@ -691,7 +926,7 @@ yynewstate:
 
 yydefault:
 	/* default state action */
-	yyn = yyDef[yystate]
+	yyn = int(yyDef[yystate])
 	if yyn == -2 {
 		if yyrcvr.char < 0 {
 			yyrcvr.char, yytoken = yylex1(yylex, &yyrcvr.lval)
@@ -700,18 +935,18 @@ yydefault:
 		/* look through exception table */
 		xi := 0
 		for {
-			if yyExca[xi+0] == -1 && yyExca[xi+1] == yystate {
+			if yyExca[xi+0] == -1 && int(yyExca[xi+1]) == yystate {
 				break
 			}
 			xi += 2
 		}
 		for xi += 2; ; xi += 2 {
-			yyn = yyExca[xi+0]
+			yyn = int(yyExca[xi+0])
 			if yyn < 0 || yyn == yytoken {
 				break
 			}
 		}
-		yyn = yyExca[xi+1]
+		yyn = int(yyExca[xi+1])
 		if yyn < 0 {
 			goto ret0
 		}
@@ -733,10 +968,10 @@ yydefault:
 
 			/* find a state where "error" is a legal shift action */
 			for yyp >= 0 {
-				yyn = yyPact[yyS[yyp].yys] + yyErrCode
+				yyn = int(yyPact[yyS[yyp].yys]) + yyErrCode
 				if yyn >= 0 && yyn < yyLast {
-					yystate = yyAct[yyn] /* simulate a shift of "error" */
-					if yyChk[yystate] == yyErrCode {
+					yystate = int(yyAct[yyn]) /* simulate a shift of "error" */
+					if int(yyChk[yystate]) == yyErrCode {
 						goto yystack
 					}
 				}
@@ -772,7 +1007,7 @@ yydefault:
 	yypt := yyp
 	_ = yypt // guard against "declared and not used"
 
-	yyp -= yyR2[yyn]
+	yyp -= int(yyR2[yyn])
 	// yyp is now the index of $0. Perform the default action. Iff the
 	// reduced production is ε, $1 is possibly out of range.
 	if yyp+1 >= len(yyS) {
@@ -783,16 +1018,16 @@ yydefault:
 	yyVAL = yyS[yyp+1]
 
 	/* consult goto table to find next state */
-	yyn = yyR1[yyn]
-	yyg := yyPgo[yyn]
+	yyn = int(yyR1[yyn])
+	yyg := int(yyPgo[yyn])
 	yyj := yyg + yyS[yyp].yys + 1
 
 	if yyj >= yyLast {
-		yystate = yyAct[yyg]
+		yystate = int(yyAct[yyg])
 	} else {
-		yystate = yyAct[yyj]
-		if yyChk[yystate] != -yyn {
-			yystate = yyAct[yyg]
+		yystate = int(yyAct[yyj])
+		if int(yyChk[yystate]) != -yyn {
+			yystate = int(yyAct[yyg])
 		}
 	}
 	// dummy call; replaced with literal code
@@ -800,783 +1035,1171 @@ yydefault:
 
 	case 1:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:146
+//line parser.y:185
 		{
 			yyVAL.i = VBool
 		}
 	case 2:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:147
+//line parser.y:186
 		{
 			yyVAL.i = VInt
 		}
 	case 3:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:148
+//line parser.y:187
 		{
 			yyVAL.i = VStr
 		}
 	case 4:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:149
+//line parser.y:188
 		{
 			yyVAL.i = VArr
 		}
 	case 5:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:150
+//line parser.y:189
 		{
 			yyVAL.i = VMap
 		}
 	case 6:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:151
+//line parser.y:190
 		{
 			yyVAL.i = VFloat
 		}
 	case 7:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:152
+//line parser.y:191
 		{
 			yyVAL.i = VMoney
 		}
 	case 8:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:153
+//line parser.y:192
 		{
 			yyVAL.i = VObject
 		}
 	case 9:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:154
+//line parser.y:193
 		{
 			yyVAL.i = VBytes
 		}
 	case 10:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:155
+//line parser.y:194
 		{
 			yyVAL.i = VFile
 		}
 	case 11:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:159
+//line parser.y:198
 		{
-			yyVAL.n = newType(yyDollar[1].i, yylex)
+			yyVAL.n = setRange(newType(yyDollar[1].i, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 12:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:160
+//line parser.y:199
 		{
-			yyVAL.n = addSubtype(yyDollar[1].n, yyDollar[3].i, yylex)
+			yyVAL.n = setFinish(addSubtype(yyDollar[1].n, yyDollar[3].i, yylex), yyDollar[3].e)
 		}
 	case 13:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:164
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:200
 		{
-			yyVAL.n = nil
+			yyVAL.n = setRange(newStructType(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 14:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:165
+//line parser.y:201
 		{
-			yyVAL.n = yyDollar[1].n
+			yyVAL.n = setRange(newTypeChain(yyDollar[1].s, yyDollar[1].p, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 15:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:169
+//line parser.y:205
 		{
 			yyVAL.n = nil
 		}
 	case 16:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:170
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:206
 		{
 			yyVAL.n = yyDollar[1].n
 		}
 	case 17:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:171
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:210
 		{
-			yyVAL.n = addStatement(yyDollar[1].n, yyDollar[2].n, yylex)
+			yyVAL.na = []*Node{yyDollar[1].n, yyDollar[3].n}
 		}
 	case 18:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:172
+//line parser.y:211
 		{
-			yyVAL.n = addStatement(yyDollar[1].n, yyDollar[2].n, yylex)
+			yyVAL.na = append(yyDollar[1].na, yyDollar[3].n)
 		}
 	case 19:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:176
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:215
 		{
-			yyVAL.n = nil
+			yyVAL.na = []*Node{yyDollar[1].n, yyDollar[3].n}
 		}
 	case 20:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:177
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:216
 		{
-			yyVAL.n = newParam(yyDollar[1].n, yylex)
+			yyVAL.na = append(yyDollar[1].na, yyDollar[3].n)
 		}
 	case 21:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:178
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:220
 		{
-			yyVAL.n = addParam(yyDollar[1].n, yyDollar[3].n)
+			yyVAL.n = newBlock(nil, nil, yylex)
 		}
 	case 22:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:182
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:221
 		{
-			yyVAL.n = nil
+			yyVAL.n = yyDollar[1].n
 		}
 	case 23:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:183
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:222
 		{
-			yyVAL.n = newContractParam(yyDollar[1].s, yyDollar[3].n, yylex)
+			yyVAL.n = addStatement(yyDollar[1].n, yyDollar[2].n, yylex)
 		}
 	case 24:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:184
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:223
 		{
-			yyVAL.n = addContractParam(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n)
+			yyVAL.n = addStatement(yyDollar[1].n, yyDollar[2].n, yylex)
 		}
 	case 25:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:188
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:227
 		{
-			yyVAL.n = newVarValue(yyDollar[1].s, yylex)
+			yyVAL.n = nil
 		}
 	case 26:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:191
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:228
 		{
-			yyVAL.n = newIndex(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newParam(yyDollar[1].n, yylex), yyDollar[1].n.Begin, yyDollar[1].n.Finish)
 		}
 	case 27:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:192
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:229
 		{
-			yyVAL.n = addIndex(yyDollar[1].n, yyDollar[3].n, yylex)
+			yyVAL.n = setFinish(addParam(yyDollar[1].n, yyDollar[3].n), yyDollar[3].n.Finish)
 		}
 	case 28:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:195
+//line parser.y:233
 		{
 			yyVAL.n = nil
 		}
 	case 29:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:196
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:234
 		{
-			yyVAL.n = yyDollar[3].n
+			yyVAL.n = newContractParam(yyDollar[1].s, yyDollar[3].n, yylex)
 		}
 	case 30:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:200
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:235
 		{
-			yyVAL.n = nil
+			yyVAL.n = addContractParam(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n)
 		}
 	case 31:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.y:201
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:239
 		{
-			yyVAL.n = newElif(yyDollar[1].n, yyDollar[3].n, yyDollar[5].n, yylex)
+			yyVAL.n = setRange(newVarValue(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 32:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:205
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:242
 		{
-			yyVAL.n = nil
+			yyVAL.n = setRange(newFieldChain(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 33:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:206
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:246
 		{
-			yyVAL.n = newCase(yyDollar[1].n, yyDollar[3].n, yyDollar[5].n, yylex)
+			yyVAL.n = setRange(newIndex(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
 		}
 	case 34:
-		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:210
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:247
 		{
-			yyVAL.n = nil
+			yyVAL.n = setFinish(addIndex(yyDollar[1].n, yyDollar[3].n, yylex), yyDollar[4].e)
 		}
 	case 35:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:211
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:250
 		{
-			yyVAL.n = yyDollar[3].n
+			yyVAL.na = []*Node{yyDollar[1].n, yyDollar[3].n}
 		}
 	case 36:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:215
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:251
 		{
-			yyVAL.n = newSwitch(yyDollar[2].n, yyDollar[4].n, yyDollar[5].n, yylex)
+			yyVAL.na = []*Node{yyDollar[1].n, nil}
 		}
 	case 37:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:219
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:252
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, ASSIGN, yylex)
+			yyVAL.na = []*Node{nil, yyDollar[2].n}
 		}
 	case 38:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:220
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:253
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, ADD_ASSIGN, yylex)
+			yyVAL.na = []*Node{nil, nil}
 		}
 	case 39:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:221
+//line parser.y:257
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, SUB_ASSIGN, yylex)
+			yyVAL.n = setRange(newSlice(setRange(newGetVar(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].p), yyDollar[2].na, yylex), yyDollar[1].p, yyDollar[3].e)
 		}
 	case 40:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:222
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:260
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, MUL_ASSIGN, yylex)
+			yyVAL.n = setRange(newSlice(yyDollar[1].n, yyDollar[3].na, yylex), yyDollar[1].p, yyDollar[4].e)
 		}
 	case 41:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:223
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:264
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, DIV_ASSIGN, yylex)
+			yyVAL.n = nil
+			yyVAL.e = Position{}
 		}
 	case 42:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:224
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:265
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, MOD_ASSIGN, yylex)
+			yyVAL.n = setRange(yyDollar[3].n, yyDollar[2].p, yyDollar[4].e)
+			yyVAL.e = yyDollar[4].e
 		}
 	case 43:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:225
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:269
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, ASSIGN, yylex)
+			yyVAL.n = nil
+			yyVAL.e = Position{}
 		}
 	case 44:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:226
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:270
 		{
-			yyVAL.n = newBinary(newVarDecl(yyDollar[1].n, []string{yyDollar[2].s}, yylex), yyDollar[4].n, ASSIGN, yylex)
+			yyVAL.n = setFinish(newElif(yyDollar[1].n, yyDollar[3].n, setRange(yyDollar[5].n, yyDollar[4].p, yyDollar[6].e), yylex), yyDollar[6].e)
+			if yyDollar[1].n == nil {
+				setBegin(yyVAL.n, yyDollar[2].p)
+			}
+			yyVAL.e = yyDollar[6].e
 		}
 	case 45:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:227
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:280
 		{
-			yyVAL.n = newVarDecl(yyDollar[1].n, yyDollar[2].sa, yylex)
+			yyVAL.n = nil
+			yyVAL.e = Position{}
 		}
 	case 46:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:228
+//line parser.y:281
 		{
-			yyVAL.n = newIf(yyDollar[2].n, yyDollar[4].n, yyDollar[6].n, yyDollar[7].n, yylex)
+			yyVAL.n = setFinish(newCase(yyDollar[1].n, yyDollar[3].n, setRange(yyDollar[5].n, yyDollar[4].p, yyDollar[6].e), yylex), yyDollar[6].e)
+			if yyDollar[1].n == nil {
+				setBegin(yyVAL.n, yyDollar[2].p)
+			}
+			yyVAL.e = yyDollar[6].e
 		}
 	case 47:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:229
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:291
 		{
-			yyVAL.n = newBreak(yylex)
+			yyVAL.n = nil
+			yyVAL.e = Position{}
 		}
 	case 48:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:230
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:292
 		{
-			yyVAL.n = newContinue(yylex)
+			yyVAL.n = setRange(yyDollar[3].n, yyDollar[2].p, yyDollar[4].e)
+			yyVAL.e = yyDollar[4].e
 		}
 	case 49:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:231
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:296
 		{
-			yyVAL.n = newReturn(nil, yylex)
+			yyVAL.n = setRange(newSwitch(yyDollar[2].n, yyDollar[4].n, yyDollar[5].n, yylex), yyDollar[1].p, lastPos(yyDollar[2].n.Finish, yyDollar[4].e, yyDollar[5].e))
 		}
 	case 50:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:232
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:302
 		{
-			yyVAL.n = newReturn(yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 51:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:233
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:303
 		{
-			yyVAL.n = newWhile(yyDollar[2].n, yyDollar[4].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ADD_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 52:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.y:234
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:304
 		{
-			yyVAL.n = newFunc(yyDollar[2].s, yyDollar[3].va, yyDollar[5].n, yyDollar[7].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, SUB_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 53:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:237
+//line parser.y:305
 		{
-			yyVAL.n = newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MUL_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 54:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:238
+//line parser.y:306
 		{
-			yyVAL.n = newCallContract(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, DIV_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 55:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:239
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:307
 		{
-			yyVAL.n = newFor(yyDollar[2].s, yyDollar[4].n, yyDollar[6].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MOD_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 56:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.y:240
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:308
 		{
-			yyVAL.n = newForAll(yyDollar[2].s, yyDollar[4].s, yyDollar[6].n, yyDollar[8].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, AND_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 57:
-		yyDollar = yyS[yypt-9 : yypt+1]
-//line parser.y:241
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:309
 		{
-			yyVAL.n = newForInt(yyDollar[2].s, yyDollar[4].n, yyDollar[6].n, yyDollar[8].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, OR_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 58:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:245
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:310
 		{
-			yyVAL.n = newArray(yyDollar[1].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, XOR_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 59:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:246
+//line parser.y:311
 		{
-			yyVAL.n = appendArray(yyDollar[1].n, yyDollar[3].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, LSHIFT_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 60:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:250
+//line parser.y:312
 		{
-			yyVAL.n = newMap(yyDollar[1].s, yyDollar[3].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, RSHIFT_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 61:
-		yyDollar = yyS[yypt-6 : yypt+1]
-//line parser.y:251
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:313
 		{
-			yyVAL.n = appendMap(yyDollar[1].n, yyDollar[3].s, yyDollar[6].n, yylex)
+			yyVAL.n = setRange(newIncDec(yyDollar[1].n, INC, yyDollar[2].p, yyDollar[2].e, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 62:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:252
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:314
 		{
-			yyVAL.n = appendMap(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex)
+			yyVAL.n = setRange(newIncDec(yyDollar[1].n, DEC, yyDollar[2].p, yyDollar[2].e, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 63:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:256
+//line parser.y:315
 		{
-			yyVAL.n = newObj(yyDollar[1].s, yyDollar[3].n, yylex)
+			yyVAL.n = setRange(newMultiAssign(yyDollar[1].na, yyDollar[3].n, yylex), yyDollar[1].na[0].Begin, yyDollar[3].n.Finish)
 		}
 	case 64:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:257
+//line parser.y:316
 		{
-			yyVAL.n = newObj(yyDollar[1].s, yyDollar[3].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ASSIGN, yylex), yyDollar[1].n.Begin, yyDollar[3].n.Finish)
 		}
 	case 65:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:258
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:317
 		{
-			yyVAL.n = appendObj(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 66:
-		yyDollar = yyS[yypt-5 : yypt+1]
-//line parser.y:259
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:318
 		{
-			yyVAL.n = appendObj(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ADD_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 67:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:263
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:319
 		{
-			yyVAL.n = newObjArr(yyDollar[1].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, SUB_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 68:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:264
+//line parser.y:320
 		{
-			yyVAL.n = appendObjArr(yyDollar[1].n, yyDollar[3].n, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MUL_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 69:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:268
+//line parser.y:321
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, DIV_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 70:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:269
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:322
 		{
-			yyVAL.n = newValue(yyDollar[1].i, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MOD_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 71:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:270
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:323
 		{
-			yyVAL.n = newValue(yyDollar[1].f, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, AND_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 72:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:271
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:324
 		{
-			yyVAL.n = newValue(yyDollar[1].s, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, OR_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 73:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:272
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:325
 		{
-			yyVAL.n = newValue(yyDollar[1].s, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, XOR_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 74:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:273
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:326
 		{
-			yyVAL.n = newValue(true, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, LSHIFT_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 75:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:274
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:327
 		{
-			yyVAL.n = newValue(false, yylex)
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, RSHIFT_ASSIGN, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 76:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:275
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:328
 		{
-			yyVAL.n = newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newIncDec(yyDollar[1].n, INC, yyDollar[2].p, yyDollar[2].e, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 77:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:276
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:329
 		{
-			yyVAL.n = newCallContract(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newIncDec(yyDollar[1].n, DEC, yyDollar[2].p, yyDollar[2].e, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 78:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:277
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:330
 		{
-			yyVAL.n = yyDollar[1].n
+			yyVAL.n = setRange(newBinary(setRange(newVarDecl(yyDollar[1].n, []string{yyDollar[2].s}, yylex), yyDollar[1].p, yyDollar[2].e), yyDollar[4].n, ASSIGN, yylex),
+				yyDollar[1].p, yyDollar[4].n.Finish)
 		}
 	case 79:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:278
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:334
 		{
-			yyVAL.n = newEnv(yyDollar[1].s, yylex)
+			yyVAL.n = setRange(newVarDecl(yyDollar[1].n, yyDollar[2].sa, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 80:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:279
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:335
 		{
-			yyVAL.n = newGetVar(yyDollar[1].s, yylex)
+			yyVAL.n = setRange(newIf(yyDollar[2].n, setRange(yyDollar[4].n, yyDollar[3].p, yyDollar[5].e), yyDollar[6].n, yyDollar[7].n, yylex), yyDollar[1].p, lastPos(yyDollar[5].e, yyDollar[6].e, yyDollar[7].e))
 		}
 	case 81:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:280
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:338
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newBreak(yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 82:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:281
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:339
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newContinue(yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 83:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:282
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:340
 		{
-			yyVAL.n = newObjList(yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newReturn(nil, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 84:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:286
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:341
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newReturn(yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[2].n.Finish)
 		}
 	case 85:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:287
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:342
 		{
-			yyVAL.n = newValue(yyDollar[1].i, yylex)
+			yyVAL.n = setRange(newReturnList(yyDollar[2].n, yyDollar[4].n, yylex), yyDollar[1].p, yyDollar[4].n.Finish)
 		}
 	case 86:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:288
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:343
 		{
-			yyVAL.n = newValue(yyDollar[1].f, yylex)
+			yyVAL.n = setRange(newWhile(yyDollar[2].n, setRange(yyDollar[4].n, yyDollar[3].p, yyDollar[5].e), yylex), yyDollar[1].p, yyDollar[5].e)
 		}
 	case 87:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:289
-		{
-			yyVAL.n = newValue(yyDollar[1].s, yylex)
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:344
+		{ // func xxx( str aaa, int bbb) int { 语句... }
+			yyVAL.n = setRange(newFunc(yyDollar[2].s, yyDollar[3].va, yyDollar[5].n, setRange(yyDollar[7].n, yyDollar[6].p, yyDollar[8].e), yylex), yyDollar[1].p, yyDollar[8].e)
 		}
 	case 88:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:290
-		{
-			yyVAL.n = newValue(yyDollar[1].s, yylex)
+		yyDollar = yyS[yypt-10 : yypt+1]
+//line parser.y:347
+		{ // func xxx(int aaa, int bbb) (int, str) { 语句... }
+			yyVAL.n = setRange(setResults(newFunc(yyDollar[2].s, yyDollar[3].va, nil, setRange(yyDollar[9].n, yyDollar[8].p, yyDollar[10].e), yylex), yyDollar[6].na), yyDollar[1].p, yyDollar[10].e)
 		}
 	case 89:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:291
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:350
 		{
-			yyVAL.n = newValue(true, yylex)
+			yyVAL.n = setRange(newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
 		}
 	case 90:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:292
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:351
 		{
-			yyVAL.n = newValue(false, yylex)
+			yyVAL.n = setRange(newCallContract(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
 		}
 	case 91:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:293
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:352
 		{
-			yyVAL.n = newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newStruct(yyDollar[2].s, yyDollar[5].va, yylex), yyDollar[1].p, yyDollar[6].e)
 		}
 	case 92:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:294
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:353
 		{
-			yyVAL.n = newCallContract(yyDollar[1].s, yyDollar[2].n, yylex)
+			yyVAL.n = setRange(newImport(yyDollar[2].s, yylex), yyDollar[1].p, yyDollar[2].e)
 		}
 	case 93:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:295
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:354
 		{
-			yyVAL.n = yyDollar[1].n
+			yyVAL.n = setRange(newSection(TConditions, setRange(yyDollar[3].n, yyDollar[2].p, yyDollar[4].e), yylex), yyDollar[1].p, yyDollar[4].e)
 		}
 	case 94:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:296
-		{
-			yyVAL.n = newEnv(yyDollar[1].s, yylex)
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:355
+		{ // try { 语句... } catch e { 语句... }
+			yyVAL.n = setRange(newTry(setRange(yyDollar[3].n, yyDollar[2].p, yyDollar[4].e), yyDollar[6].s, setRange(yyDollar[8].n, yyDollar[7].p, yyDollar[9].e), yylex), yyDollar[1].p, yyDollar[9].e)
 		}
 	case 95:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:297
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:358
 		{
-			yyVAL.n = newGetVar(yyDollar[1].s, yylex)
+			yyVAL.n = setRange(newSection(TAction, setRange(yyDollar[3].n, yyDollar[2].p, yyDollar[4].e), yylex), yyDollar[1].p, yyDollar[4].e)
 		}
 	case 96:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:298
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:359
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newFor(yyDollar[2].s, yyDollar[4].n, setRange(yyDollar[6].n, yyDollar[5].p, yyDollar[7].e), yylex), yyDollar[1].p, yyDollar[7].e)
 		}
 	case 97:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:299
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:360
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newForAll(yyDollar[2].s, yyDollar[4].s, yyDollar[6].n, setRange(yyDollar[8].n, yyDollar[7].p, yyDollar[9].e), yylex), yyDollar[1].p, yyDollar[9].e)
 		}
 	case 98:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:300
+		yyDollar = yyS[yypt-9 : yypt+1]
+//line parser.y:361
 		{
-			yyVAL.n = yyDollar[2].n
+			yyVAL.n = setRange(newForInt(yyDollar[2].s, yyDollar[4].n, yyDollar[6].n, setRange(yyDollar[8].n, yyDollar[7].p, yyDollar[9].e), yylex), yyDollar[1].p, yyDollar[9].e)
 		}
 	case 99:
-		yyDollar = yyS[yypt-8 : yypt+1]
-//line parser.y:301
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:365
 		{
-			yyVAL.n = newQuestion(yyDollar[3].n, yyDollar[5].n, yyDollar[7].n, yylex)
+			yyVAL.n = setRange(newArray(yyDollar[1].n, yylex), yyDollar[1].n.Begin, yyDollar[1].n.Finish)
 		}
 	case 100:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:302
+//line parser.y:366
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, MUL, yylex)
+			yyVAL.n = setFinish(appendArray(yyDollar[1].n, yyDollar[3].n, yylex), yyDollar[3].n.Finish)
 		}
 	case 101:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:303
+//line parser.y:370
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, DIV, yylex)
+			yyVAL.n = setRange(newMap(yyDollar[1].s, yyDollar[3].n, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 102:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:304
+		yyDollar = yyS[yypt-6 : yypt+1]
+//line parser.y:371
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, ADD, yylex)
+			yyVAL.n = setFinish(appendMap(yyDollar[1].n, yyDollar[3].s, yyDollar[6].n, yylex), yyDollar[6].n.Finish)
 		}
 	case 103:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:305
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:372
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, SUB, yylex)
+			yyVAL.n = setFinish(appendMap(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex), yyDollar[5].n.Finish)
 		}
 	case 104:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:306
+//line parser.y:376
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, MOD, yylex)
+			yyVAL.n = setRange(newObj(yyDollar[1].s, yyDollar[3].n, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 105:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:307
+//line parser.y:377
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, AND, yylex)
+			yyVAL.n = setRange(newObj(yyDollar[1].s, yyDollar[3].n, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
 		}
 	case 106:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:308
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:378
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, OR, yylex)
+			yyVAL.n = setFinish(appendObj(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex), yyDollar[5].n.Finish)
 		}
 	case 107:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:309
+		yyDollar = yyS[yypt-5 : yypt+1]
+//line parser.y:379
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, EQ, yylex)
+			yyVAL.n = setFinish(appendObj(yyDollar[1].n, yyDollar[3].s, yyDollar[5].n, yylex), yyDollar[5].n.Finish)
 		}
 	case 108:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:310
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:383
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, NOT_EQ, yylex)
+			yyVAL.n = setRange(newObjArr(yyDollar[1].n, yylex), yyDollar[1].n.Begin, yyDollar[1].n.Finish)
 		}
 	case 109:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:311
+//line parser.y:384
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, LTE, yylex)
+			yyVAL.n = setFinish(appendObjArr(yyDollar[1].n, yyDollar[3].n, yylex), yyDollar[3].n.Finish)
 		}
 	case 110:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:312
+//line parser.y:388
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, GTE, yylex)
+			yyVAL.n = yyDollar[2].n
 		}
 	case 111:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:313
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:389
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, LT, yylex)
+			yyVAL.n = setRange(newValue(yyDollar[1].i, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 112:
-		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:314
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:390
 		{
-			yyVAL.n = newBinary(yyDollar[1].n, yyDollar[3].n, GT, yylex)
+			yyVAL.n = setRange(newValue(yyDollar[1].f, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 113:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:316
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:391
 		{
-			yyVAL.n = newUnary(yyDollar[2].n, SUB, yylex)
+			yyVAL.n = setRange(newValue(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 114:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:317
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:392
 		{
-			yyVAL.n = newUnary(yyDollar[2].n, NOT, yylex)
+			yyVAL.n = setRange(newValue(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
 		}
 	case 115:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:321
+//line parser.y:393
 		{
-			yyVAL.sa = []string{yyDollar[1].s}
+			yyVAL.n = newInterp(yyDollar[1].s, yyDollar[1].p, yyDollar[1].e, yylex)
 		}
 	case 116:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:394
+		{
+			yyVAL.n = setRange(newValue(true, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 117:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:395
+		{
+			yyVAL.n = setRange(newValue(false, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 118:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:396
+		{
+			yyVAL.n = setRange(newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 119:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:397
+		{
+			yyVAL.n = setRange(newCallContract(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 120:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:398
+		{
+			yyVAL.n = yyDollar[1].n
+		}
+	case 121:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:399
+		{
+			yyVAL.n = yyDollar[1].n
+		}
+	case 122:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:400
+		{
+			yyVAL.n = setRange(newEnv(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 123:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:401
+		{
+			yyVAL.n = setRange(newGetVar(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 124:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:402
+		{
+			yyVAL.n = setRange(yyDollar[2].n, yyDollar[1].p, yyDollar[3].e)
+		}
+	case 125:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:403
+		{
+			yyVAL.n = setRange(yyDollar[2].n, yyDollar[1].p, yyDollar[3].e)
+		}
+	case 126:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:404
+		{
+			yyVAL.n = setRange(newObjList(yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 127:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:409
+		{
+			yyVAL.n = yyDollar[2].n
+		}
+	case 128:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:410
+		{
+			yyVAL.n = setRange(newValue(yyDollar[1].i, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 129:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:411
+		{
+			yyVAL.n = setRange(newValue(yyDollar[1].f, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 130:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:412
+		{
+			yyVAL.n = setRange(newValue(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 131:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:413
+		{
+			yyVAL.n = setRange(newValue(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 132:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:414
+		{
+			yyVAL.n = newInterp(yyDollar[1].s, yyDollar[1].p, yyDollar[1].e, yylex)
+		}
+	case 133:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:415
+		{
+			yyVAL.n = setRange(newValue(true, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 134:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:416
+		{
+			yyVAL.n = setRange(newValue(false, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 135:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:417
+		{
+			yyVAL.n = setRange(newCallFunc(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 136:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:418
+		{
+			yyVAL.n = setRange(newCallContract(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 137:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:419
+		{
+			yyVAL.n = yyDollar[1].n
+		}
+	case 138:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:420
+		{
+			yyVAL.n = yyDollar[1].n
+		}
+	case 139:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:421
+		{
+			yyVAL.n = yyDollar[1].n
+		}
+	case 140:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:422
+		{
+			yyVAL.n = setRange(newStructValue(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[3].e)
+		}
+	case 141:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:423
+		{
+			yyVAL.n = setRange(newStructValue(yyDollar[1].s, yyDollar[2].n, yylex), yyDollar[1].p, yyDollar[4].e)
+		}
+	case 142:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:424
+		{
+			yyVAL.n = setRange(newEnv(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 143:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:425
+		{
+			yyVAL.n = setRange(newGetVar(yyDollar[1].s, yylex), yyDollar[1].p, yyDollar[1].e)
+		}
+	case 144:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:426
+		{
+			yyVAL.n = setRange(yyDollar[2].n, yyDollar[1].p, yyDollar[3].e)
+		}
+	case 145:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:427
+		{
+			yyVAL.n = setRange(yyDollar[2].n, yyDollar[1].p, yyDollar[3].e)
+		}
+	case 146:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:428
+		{
+			yyVAL.n = setRange(yyDollar[2].n, yyDollar[1].p, yyDollar[3].e)
+		}
+	case 147:
+		yyDollar = yyS[yypt-8 : yypt+1]
+//line parser.y:429
+		{
+			yyVAL.n = setRange(newQuestion(yyDollar[3].n, yyDollar[5].n, yyDollar[7].n, yylex), yyDollar[1].p, yyDollar[8].e)
+		}
+	case 148:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:430
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MUL, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 149:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:431
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, DIV, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 150:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:432
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, ADD, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 151:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:433
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, SUB, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 152:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:434
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, MOD, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 153:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:435
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, BIT_AND, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 154:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:436
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, BIT_OR, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 155:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:437
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, BIT_XOR, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 156:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:438
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, LSHIFT, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 157:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:439
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, RSHIFT, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 158:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:440
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, AND, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 159:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:441
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, OR, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 160:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:442
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, EQ, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 161:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:443
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, NOT_EQ, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 162:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:444
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, LTE, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 163:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:445
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, GTE, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 164:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:446
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, LT, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 165:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:447
+		{
+			yyVAL.n = setRange(newBinary(yyDollar[1].n, yyDollar[3].n, GT, yylex), yyDollar[1].p, yyDollar[3].n.Finish)
+		}
+	case 166:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:322
+//line parser.y:449
+		{
+			yyVAL.n = setRange(newUnary(yyDollar[2].n, SUB, yylex), yyDollar[1].p, yyDollar[2].n.Finish)
+		}
+	case 167:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:450
+		{
+			yyVAL.n = setRange(newUnary(yyDollar[2].n, NOT, yylex), yyDollar[1].p, yyDollar[2].n.Finish)
+		}
+	case 168:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:451
+		{
+			yyVAL.n = setRange(newUnary(yyDollar[2].n, BIT_NOT, yylex), yyDollar[1].p, yyDollar[2].n.Finish)
+		}
+	case 169:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:455
+		{
+			yyVAL.sa = []string{yyDollar[1].s}
+		}
+	case 170:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:456
 		{
 			yyVAL.sa = append(yyDollar[1].sa, yyDollar[2].s)
+			yyVAL.e = yyDollar[2].e
 		}
-	case 117:
+	case 171:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:326
+//line parser.y:460
 		{
 			yyVAL.va = newVars(yyDollar[1].n, yyDollar[2].sa)
 		}
-	case 118:
+	case 172:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:330
+//line parser.y:464
 		{
 			yyVAL.va = nil
 		}
-	case 119:
-		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:331
+	case 173:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:465
 		{
 			yyVAL.va = yyDollar[1].va
 		}
-	case 120:
+	case 174:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:332
+//line parser.y:466
 		{
-			yyVAL.va = append(yyDollar[1].va, yyDollar[3].va...)
+			yyVAL.va = append(yyDollar[1].va, yyDollar[2].va...)
 		}
-	case 121:
+	case 175:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:471
+		{
+			yyVAL.va = yyDollar[1].va
+		}
+	case 176:
 		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:336
+//line parser.y:472
 		{
-			yyVAL.va = newVars(yyDollar[1].n, yyDollar[2].sa)
+			yyVAL.va = append(yyDollar[1].va, yyDollar[2].va...)
 		}
-	case 122:
-		yyDollar = yyS[yypt-4 : yypt+1]
-//line parser.y:337
+	case 177:
+		yyDollar = yyS[yypt-0 : yypt+1]
+//line parser.y:476
 		{
-			yyVAL.va = newVarExp(yyDollar[1].n, yyDollar[2].s, yyDollar[4].n, yylex)
+			yyVAL.va = nil
 		}
-	case 123:
+	case 178:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:341
+//line parser.y:477
 		{
 			yyVAL.va = yyDollar[1].va
 		}
-	case 124:
+	case 179:
 		yyDollar = yyS[yypt-3 : yypt+1]
-//line parser.y:342
+//line parser.y:478
 		{
 			yyVAL.va = append(yyDollar[1].va, yyDollar[3].va...)
 		}
-	case 125:
+	case 180:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:482
+		{
+			yyVAL.va = newVars(yyDollar[1].n, yyDollar[2].sa)
+		}
+	case 181:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:483
+		{
+			yyVAL.va = setAttr(newVars(yyDollar[1].n, yyDollar[2].sa), yyDollar[3].s)
+		}
+	case 182:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:484
+		{
+			yyVAL.va = setAttr(newVars(yyDollar[1].n, yyDollar[2].sa), yyDollar[3].s)
+		}
+	case 183:
+		yyDollar = yyS[yypt-4 : yypt+1]
+//line parser.y:485
+		{
+			yyVAL.va = newVarExp(yyDollar[1].n, yyDollar[2].s, yyDollar[4].n, yylex)
+			setRange(yyVAL.va[0].Exp, yyDollar[1].p, yyDollar[4].n.Finish)
+			setRange(yyVAL.va[0].Exp.Value.(*NBinary).Left, yyDollar[2].p, yyDollar[2].e)
+		}
+	case 184:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:346
+//line parser.y:493
 		{
 			yyVAL.va = nil
 		}
-	case 126:
-		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:347
+	case 185:
+		yyDollar = yyS[yypt-2 : yypt+1]
+//line parser.y:494
 		{
-			yyVAL.va = yyDollar[4].va
+			yyVAL.va = yyDollar[1].va
 		}
-	case 127:
-		yyDollar = yyS[yypt-2 : yypt+1]
-//line parser.y:351
+	case 186:
+		yyDollar = yyS[yypt-3 : yypt+1]
+//line parser.y:495
 		{
-			yyVAL.n = newBlock(yyDollar[1].va, yyDollar[2].n, yylex)
+			yyVAL.va = append(yyDollar[1].va, yyDollar[2].va...)
 		}
-	case 128:
+	case 187:
+		yyDollar = yyS[yypt-1 : yypt+1]
+//line parser.y:500
+		{
+			yyVAL.n = newBlock(nil, yyDollar[1].n, yylex)
+		}
+	case 188:
+		yyDollar = yyS[yypt-7 : yypt+1]
+//line parser.y:501
+		{ // 合约data 和 语句列表
+			if len(yyDollar[1].n.Value.(*NBlock).Statements) > 0 {
+				yylex.Error(errDataFirst)
+			}
+			yyVAL.n = newBlock(yyDollar[4].va, yyDollar[7].n, yylex)
+			setData(yylex, yyVAL.n, yyDollar[2].p, yyDollar[5].p)
+		}
+	case 189:
 		yyDollar = yyS[yypt-0 : yypt+1]
-//line parser.y:357
+//line parser.y:512
 		{
 			yyVAL.b = false
 		}
-	case 129:
+	case 190:
 		yyDollar = yyS[yypt-1 : yypt+1]
-//line parser.y:358
+//line parser.y:513
 		{
 			yyVAL.b = true
 		}
-	case 130:
+	case 191:
 		yyDollar = yyS[yypt-7 : yypt+1]
-//line parser.y:362
-		{
-			yyVAL.n = newContract(yyDollar[2].s, yyDollar[3].b, yyDollar[6].n, yylex)
+//line parser.y:518
+		{ // contract xxx read {换行 合约主体 }
+			yyVAL.n = setRange(newContract(yyDollar[2].s, yyDollar[3].b, yyDollar[1].b, setRange(yyDollar[6].n, yyDollar[4].p, yyDollar[7].e), yylex), yyDollar[1].p, yyDollar[7].e)
 			setResult(yylex, yyVAL.n)
 		}
 	}
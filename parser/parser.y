@@ -1,8 +1,14 @@
 %{
 package parser
 
+const errDataFirst = `data must be declared before statements`
+
 func setResult(l yyLexer, v *Node) {
-  l.(*lexer).result = v
+  l.(*lexer).result = append(l.(*lexer).result, v)
+}
+
+func setData(l yyLexer, block *Node, begin, end Position) {
+  l.(*lexer).data[block] = []Position{begin, end}
 }
 
 %}
@@ -15,6 +21,9 @@ func setResult(l yyLexer, v *Node) {
     s       string
     sa      []string
     va      []NVar
+    na      []*Node
+    p       Position    // the position of the first token
+    e       Position    // the position after the last token
 }
 
 // Identifiers + literals
@@ -23,10 +32,13 @@ func setResult(l yyLexer, v *Node) {
 %token<s> CALL  // foobar(
 %token<s> CALLCONTRACT  // @foobar(
 %token<s> INDEX  // foobar[
+%token<s> STRUCTVALUE  // Foobar{
+%token<s> FIELD  // foo.bar
 %token<i> INT    // 314
 %token<f> FLOAT    // 3.14
 %token<s> STRING  // "string"
 %token<s> QSTRING  // `string`
+%token<s> ISTRING  // "string ${expr}"
 %token<b> TRUE   // true
 %token<b> FALSE  // false
 
@@ -57,7 +69,14 @@ func setResult(l yyLexer, v *Node) {
 %token MUL_ASSIGN // *=
 %token DIV_ASSIGN // /=
 %token MOD_ASSIGN // %=
+%token AND_ASSIGN // &=
+%token OR_ASSIGN // |=
+%token XOR_ASSIGN // ^=
+%token LSHIFT_ASSIGN // <<=
+%token RSHIFT_ASSIGN // >>=
 %token ASSIGN // =
+%token INC // ++
+%token DEC // --
 
 %token AND // &&
 %token OR  // ||
@@ -66,6 +85,13 @@ func setResult(l yyLexer, v *Node) {
 %token NOT_EQ // !=
 %token NOT    // !
 
+%token BIT_AND // &
+%token BIT_OR  // |
+%token BIT_XOR // ^
+%token BIT_NOT // ~
+%token LSHIFT  // <<
+%token RSHIFT  // >>
+
 %token LT     // <
 %token GT     // >
 %token LTE    // <=
@@ -75,7 +101,7 @@ func setResult(l yyLexer, v *Node) {
 %token BREAK      // break
 %token CONTINUE   // continue
 %token DATA       // data
-%token CONTRACT   // contract
+%token CONTRACT   // contract or library
 %token IF       // if
 %token ELIF     // elif
 %token ELSE     // else
@@ -88,6 +114,13 @@ func setResult(l yyLexer, v *Node) {
 %token CASE    // case
 %token READ    // read
 %token DEFAULT // default
+%token IMPORT  // import
+%token CONDITIONS // conditions
+%token ACTION     // action
+%token TRY        // try
+%token CATCH      // catch
+%token TYPE       // type
+%token STRUCT     // struct
 
 // Types
 %token T_INT    // int
@@ -103,13 +136,17 @@ func setResult(l yyLexer, v *Node) {
 
 %type <i> ordinaltype
 %type <n> type
+%type <va> struct_fields
+%type <va> struct_body
+%type <n> field
 %type <n> rettype
+%type <na> typelist
+%type <na> varlist
 %type <sa> ident_list
 %type <va> var_declaration
 %type <va> var_declarations
 %type <va> par_declaration
 %type <va> par_declarations
-%type <va> contract_data
 %type <n> params
 %type <n> var
 %type <n> expr
@@ -124,6 +161,8 @@ func setResult(l yyLexer, v *Node) {
 %type <n> contract_body
 %type <n> contract_declaration
 %type <n> index
+%type <n> slice
+%type <na> slice_range
 %type <n> exprlist
 %type <n> exprmaplist
 %type <n> exprobj
@@ -134,8 +173,8 @@ func setResult(l yyLexer, v *Node) {
 %left AND 
 %left OR
 %left LTE GTE LT GT EQ NOT_EQ
-%left ADD SUB
-%left MUL DIV MOD
+%left ADD SUB BIT_OR BIT_XOR
+%left MUL DIV MOD BIT_AND LSHIFT RSHIFT
 %right UNARYMINUS UNARYNOT
 
 %start contract_declaration
@@ -156,8 +195,10 @@ ordinaltype
     ;
 
 type
-    : ordinaltype {$$ = newType($1, yylex)}
-    | type DOT ordinaltype {$$ = addSubtype($1, $3, yylex)}
+    : ordinaltype {$$ = setRange(newType($1, yylex), $<p>1, $<e>1)}
+    | type DOT ordinaltype {$$ = setFinish(addSubtype($1, $3, yylex), $<e>3)}
+    | IDENT { $$ = setRange(newStructType($1, yylex), $<p>1, $<e>1) }	// 结构体类型
+    | FIELD { $$ = setRange(newTypeChain($1, $<p>1, yylex), $<p>1, $<e>1) }	// arr.str被识别为一个单词
     ;
 
 rettype
@@ -165,8 +206,18 @@ rettype
     | type { $$ = $1 }
     ;
 
+typelist
+    : type COMMA type { $$ = []*Node{$1, $3} }
+    | typelist COMMA type { $$ = append($1, $3) }
+    ;
+
+varlist
+    : var COMMA var { $$ = []*Node{$1, $3} }
+    | varlist COMMA var { $$ = append($1, $3) }
+    ;
+
 statements
-    : /*empty*/ { $$ = nil }
+    : /*empty*/ { $$ = newBlock(nil, nil, yylex) }
     | statements NEWLINE { $$ = $1 }	// 语句列表 新行
     | statements switch { $$ = addStatement($1, $2, yylex)}	// 语句列表 switch语句
     | statements statement NEWLINE { $$ = addStatement($1, $2, yylex)}
@@ -174,8 +225,8 @@ statements
 
 params
     : /*empty*/ { $$ = nil }
-    | expr { $$ = newParam( $1, yylex ) }
-    | params COMMA expr { $$ = addParam($1, $3)}
+    | expr { $$ = setRange(newParam( $1, yylex ), $1.Begin, $1.Finish) }
+    | params COMMA expr { $$ = setFinish(addParam($1, $3), $3.Finish)}
     ;
 
 cntparams
@@ -185,148 +236,242 @@ cntparams
     ;
 
 var 
-    : IDENT { $$ = newVarValue($1, yylex); }
+    : IDENT { $$ = setRange(newVarValue($1, yylex), $<p>1, $<e>1); }
+
+field
+    : FIELD { $$ = setRange(newFieldChain($1, yylex), $<p>1, $<e>1) }
+    ;
 
 index 
-    : INDEX expr RBRACKET { $$ = newIndex($1, $2, yylex);}
-    | index LBRACKET expr RBRACKET { $$ = addIndex($1, $3, yylex);}
+    : INDEX expr RBRACKET { $$ = setRange(newIndex($1, $2, yylex), $<p>1, $<e>3);}
+    | index LBRACKET expr RBRACKET { $$ = setFinish(addIndex($1, $3, yylex), $<e>4);}
+
+slice_range
+    : expr COLON expr { $$ = []*Node{$1, $3} }
+    | expr COLON { $$ = []*Node{$1, nil} }
+    | COLON expr { $$ = []*Node{nil, $2} }
+    | COLON { $$ = []*Node{nil, nil} }
+    ;
+
+slice
+    : INDEX slice_range RBRACKET {
+        $$ = setRange(newSlice(setRange(newGetVar($1, yylex), $<p>1, $<p>1), $2, yylex), $<p>1, $<e>3)
+    }	// xxx[1:2]
+    | index LBRACKET slice_range RBRACKET { $$ = setRange(newSlice($1, $3, yylex), $<p>1, $<e>4) }	// xxx[1][1:2]
+    ;
 
 else 
-   : /*empty*/ {$$ = nil}
-   | ELSE LBRACE statements RBRACE { $$ = $3 }
+   : /*empty*/ { $$ = nil; $<e>$ = Position{} }
+   | ELSE LBRACE statements RBRACE { $$ = setRange($3, $<p>2, $<e>4); $<e>$ = $<e>4 }
    ;
 
 elif
-   : /*empty*/ {$$ = nil}
-   | elif ELIF expr LBRACE statements RBRACE { $$ = newElif($1, $3, $5, yylex) }
+   : /*empty*/ { $$ = nil; $<e>$ = Position{} }
+   | elif ELIF expr LBRACE statements RBRACE {
+        $$ = setFinish(newElif($1, $3, setRange($5, $<p>4, $<e>6), yylex), $<e>6)
+        if $1 == nil {
+            setBegin($$, $<p>2)
+        }
+        $<e>$ = $<e>6
+    }
    ;
 
 case
-   : /*empty*/ {$$ = nil}
-   | case CASE exprlist LBRACE statements RBRACE NEWLINE { $$ = newCase($1, $3, $5, yylex) }
+   : /*empty*/ { $$ = nil; $<e>$ = Position{} }
+   | case CASE exprlist LBRACE statements RBRACE NEWLINE {
+        $$ = setFinish(newCase($1, $3, setRange($5, $<p>4, $<e>6), yylex), $<e>6)
+        if $1 == nil {
+            setBegin($$, $<p>2)
+        }
+        $<e>$ = $<e>6
+    }
    ;
 
 default 
-   : /*empty*/ {$$ = nil}
-   | DEFAULT LBRACE statements RBRACE { $$ = $3 }
+   : /*empty*/ { $$ = nil; $<e>$ = Position{} }
+   | DEFAULT LBRACE statements RBRACE { $$ = setRange($3, $<p>2, $<e>4); $<e>$ = $<e>4 }
    ;
 
 switch
-    : SWITCH expr NEWLINE case default { $$ = newSwitch( $2, $4, $5, yylex ) }
+    : SWITCH expr NEWLINE case default {
+        $$ = setRange(newSwitch( $2, $4, $5, yylex ), $<p>1, lastPos($2.Finish, $<e>4, $<e>5))
+    }
     ;
 
 statement 
-    : var ASSIGN expr { $$ = newBinary($1, $3, ASSIGN, yylex) }	// xxx = 表达式
-    | var ADD_ASSIGN expr { $$ = newBinary($1, $3, ADD_ASSIGN, yylex); }	// xxx += 表达式
-    | var SUB_ASSIGN expr { $$ = newBinary($1, $3, SUB_ASSIGN, yylex); }	// xxx -= 表达式
-    | var MUL_ASSIGN expr { $$ = newBinary($1, $3, MUL_ASSIGN, yylex); }	// xxx += 表达式
-    | var DIV_ASSIGN expr { $$ = newBinary($1, $3, DIV_ASSIGN, yylex); }	// xxx /= 表达式
-    | var MOD_ASSIGN expr { $$ = newBinary($1, $3, MOD_ASSIGN, yylex); } 	// xxx %= 表达式
-    | index ASSIGN expr { $$ = newBinary($1, $3, ASSIGN, yylex) }		// xxx[yyy] = 表达式
-    | type IDENT ASSIGN expr { $$ = newBinary( newVarDecl( $1, []string{$2}, yylex ), $4, ASSIGN, yylex) }	// str xxx = 表达式
-    | type ident_list { $$ = newVarDecl( $1, $2, yylex )}	// str aaa,bbb,ccc
-    | IF expr LBRACE statements RBRACE elif else { $$ = newIf( $2, $4, $6, $7, yylex )}	// if(表达式) { 语句... } elif else
-    | BREAK { $$ = newBreak(yylex); }	//  break
-    | CONTINUE { $$ = newContinue(yylex); }	// continue
-    | RETURN { $$ = newReturn(nil, yylex); }	// return
-    | RETURN expr { $$ = newReturn($2, yylex); }	// return 表达式
-    | WHILE expr LBRACE statements RBRACE { $$ = newWhile( $2, $4, yylex )}	// while 表达式 { 语句... }
+    : var ASSIGN expr { $$ = setRange(newBinary($1, $3, ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx = 表达式
+    | var ADD_ASSIGN expr { $$ = setRange(newBinary($1, $3, ADD_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx += 表达式
+    | var SUB_ASSIGN expr { $$ = setRange(newBinary($1, $3, SUB_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx -= 表达式
+    | var MUL_ASSIGN expr { $$ = setRange(newBinary($1, $3, MUL_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx += 表达式
+    | var DIV_ASSIGN expr { $$ = setRange(newBinary($1, $3, DIV_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx /= 表达式
+    | var MOD_ASSIGN expr { $$ = setRange(newBinary($1, $3, MOD_ASSIGN, yylex), $<p>1, $3.Finish) } 	// xxx %= 表达式
+    | var AND_ASSIGN expr { $$ = setRange(newBinary($1, $3, AND_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx &= 表达式
+    | var OR_ASSIGN expr { $$ = setRange(newBinary($1, $3, OR_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx |= 表达式
+    | var XOR_ASSIGN expr { $$ = setRange(newBinary($1, $3, XOR_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx ^= 表达式
+    | var LSHIFT_ASSIGN expr { $$ = setRange(newBinary($1, $3, LSHIFT_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx <<= 表达式
+    | var RSHIFT_ASSIGN expr { $$ = setRange(newBinary($1, $3, RSHIFT_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx >>= 表达式
+    | var INC { $$ = setRange(newIncDec($1, INC, $<p>2, $<e>2, yylex), $<p>1, $<e>2) }	// xxx++
+    | var DEC { $$ = setRange(newIncDec($1, DEC, $<p>2, $<e>2, yylex), $<p>1, $<e>2) }	// xxx--
+    | varlist ASSIGN expr { $$ = setRange(newMultiAssign($1, $3, yylex), $1[0].Begin, $3.Finish) }	// xxx, yyy = 函数调用
+    | field ASSIGN expr { $$ = setRange(newBinary($1, $3, ASSIGN, yylex), $1.Begin, $3.Finish) }	// xxx.yyy = 表达式
+    | index ASSIGN expr { $$ = setRange(newBinary($1, $3, ASSIGN, yylex), $<p>1, $3.Finish) }		// xxx[yyy] = 表达式
+    | index ADD_ASSIGN expr { $$ = setRange(newBinary($1, $3, ADD_ASSIGN, yylex), $<p>1, $3.Finish) }	// xxx[yyy] += 表达式
+    | index SUB_ASSIGN expr { $$ = setRange(newBinary($1, $3, SUB_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index MUL_ASSIGN expr { $$ = setRange(newBinary($1, $3, MUL_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index DIV_ASSIGN expr { $$ = setRange(newBinary($1, $3, DIV_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index MOD_ASSIGN expr { $$ = setRange(newBinary($1, $3, MOD_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index AND_ASSIGN expr { $$ = setRange(newBinary($1, $3, AND_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index OR_ASSIGN expr { $$ = setRange(newBinary($1, $3, OR_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index XOR_ASSIGN expr { $$ = setRange(newBinary($1, $3, XOR_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index LSHIFT_ASSIGN expr { $$ = setRange(newBinary($1, $3, LSHIFT_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index RSHIFT_ASSIGN expr { $$ = setRange(newBinary($1, $3, RSHIFT_ASSIGN, yylex), $<p>1, $3.Finish) }
+    | index INC { $$ = setRange(newIncDec($1, INC, $<p>2, $<e>2, yylex), $<p>1, $<e>2) }	// xxx[yyy]++
+    | index DEC { $$ = setRange(newIncDec($1, DEC, $<p>2, $<e>2, yylex), $<p>1, $<e>2) }	// xxx[yyy]--
+    | type IDENT ASSIGN expr {
+        $$ = setRange(newBinary(setRange(newVarDecl( $1, []string{$2}, yylex ), $<p>1, $<e>2), $4, ASSIGN, yylex),
+            $<p>1, $4.Finish)
+    }	// str xxx = 表达式
+    | type ident_list { $$ = setRange(newVarDecl( $1, $2, yylex ), $<p>1, $<e>2)}	// str aaa,bbb,ccc
+    | IF expr LBRACE statements RBRACE elif else {
+        $$ = setRange(newIf( $2, setRange($4, $<p>3, $<e>5), $6, $7, yylex ), $<p>1, lastPos($<e>5, $<e>6, $<e>7))
+    }	// if(表达式) { 语句... } elif else
+    | BREAK { $$ = setRange(newBreak(yylex), $<p>1, $<e>1) }	//  break
+    | CONTINUE { $$ = setRange(newContinue(yylex), $<p>1, $<e>1) }	// continue
+    | RETURN { $$ = setRange(newReturn(nil, yylex), $<p>1, $<e>1) }	// return
+    | RETURN expr { $$ = setRange(newReturn($2, yylex), $<p>1, $2.Finish) }	// return 表达式
+    | RETURN expr COMMA exprlist { $$ = setRange(newReturnList($2, $4, yylex), $<p>1, $4.Finish) }	// return 表达式, 表达式
+    | WHILE expr LBRACE statements RBRACE { $$ = setRange(newWhile( $2, setRange($4, $<p>3, $<e>5), yylex ), $<p>1, $<e>5)}	// while 表达式 { 语句... }
     | FUNC CALL par_declarations RPAREN rettype LBRACE statements RBRACE { 	// func xxx( str aaa, int bbb) int { 语句... }
-           $$ = newFunc($2, $3, $5, $7, yylex)
+           $$ = setRange(newFunc($2, $3, $5, setRange($7, $<p>6, $<e>8), yylex), $<p>1, $<e>8)
            }
-    | CALL params RPAREN { $$ = newCallFunc($1, $2, yylex);}	// xxx(表达式)
-    | CALLCONTRACT cntparams RPAREN { $$ = newCallContract($1, $2, yylex);}	// @xxx(key1: 表达式, key2: 表达式)
-    | FOR IDENT IN expr LBRACE statements RBRACE { $$ = newFor( $2, $4, $6, yylex )}	// for x in 表达式 { 语句.. }
-    | FOR IDENT COMMA IDENT IN expr LBRACE statements RBRACE { $$ = newForAll( $2, $4, $6, $8, yylex )}	// for x,y in 表达式 { 语句... }
-    | FOR IDENT IN expr DOUBLEDOT expr LBRACE statements RBRACE { $$ = newForInt( $2, $4, $6, $8, yylex )}	// for x in 表达式 ... 表达式 { 语句... }
+    | FUNC CALL par_declarations RPAREN LPAREN typelist RPAREN LBRACE statements RBRACE { 	// func xxx(int aaa, int bbb) (int, str) { 语句... }
+           $$ = setRange(setResults(newFunc($2, $3, nil, setRange($9, $<p>8, $<e>10), yylex), $6), $<p>1, $<e>10)
+           }
+    | CALL params RPAREN { $$ = setRange(newCallFunc($1, $2, yylex), $<p>1, $<e>3)}	// xxx(表达式)
+    | CALLCONTRACT cntparams RPAREN { $$ = setRange(newCallContract($1, $2, yylex), $<p>1, $<e>3)}	// @xxx(key1: 表达式, key2: 表达式)
+    | TYPE IDENT STRUCT LBRACE struct_body RBRACE { $$ = setRange(newStruct($2, $5, yylex), $<p>1, $<e>6) }	// type xxx struct { 字段... }
+    | IMPORT IDENT { $$ = setRange(newImport($2, yylex), $<p>1, $<e>2) }	// import 库名
+    | CONDITIONS LBRACE statements RBRACE { $$ = setRange(newSection(TConditions, setRange($3, $<p>2, $<e>4), yylex), $<p>1, $<e>4)}	// conditions { 语句... }
+    | TRY LBRACE statements RBRACE CATCH IDENT LBRACE statements RBRACE {	// try { 语句... } catch e { 语句... }
+        $$ = setRange(newTry(setRange($3, $<p>2, $<e>4), $6, setRange($8, $<p>7, $<e>9), yylex), $<p>1, $<e>9)
+    }
+    | ACTION LBRACE statements RBRACE { $$ = setRange(newSection(TAction, setRange($3, $<p>2, $<e>4), yylex), $<p>1, $<e>4)}	// action { 语句... }
+    | FOR IDENT IN expr LBRACE statements RBRACE { $$ = setRange(newFor( $2, $4, setRange($6, $<p>5, $<e>7), yylex ), $<p>1, $<e>7)}	// for x in 表达式 { 语句.. }
+    | FOR IDENT COMMA IDENT IN expr LBRACE statements RBRACE { $$ = setRange(newForAll( $2, $4, $6, setRange($8, $<p>7, $<e>9), yylex ), $<p>1, $<e>9)}	// for x,y in 表达式 { 语句... }
+    | FOR IDENT IN expr DOUBLEDOT expr LBRACE statements RBRACE { $$ = setRange(newForInt( $2, $4, $6, setRange($8, $<p>7, $<e>9), yylex ), $<p>1, $<e>9)}	// for x in 表达式 ... 表达式 { 语句... }
     ;
 
 exprlist
-    : expr { $$ = newArray($1, yylex); }
-    | exprlist COMMA expr { $$ = appendArray($1, $3, yylex);}
+    : expr { $$ = setRange(newArray($1, yylex), $1.Begin, $1.Finish) }
+    | exprlist COMMA expr { $$ = setFinish(appendArray($1, $3, yylex), $3.Finish)}
     ;   
 
 exprmaplist
-    : STRING COLON expr { $$ = newMap($1, $3, yylex); }
-    | exprmaplist COMMA STRING COLON NEWLINE expr { $$ = appendMap($1, $3, $6, yylex); }
-    | exprmaplist COMMA STRING COLON expr { $$ = appendMap($1, $3, $5, yylex); }
+    : STRING COLON expr { $$ = setRange(newMap($1, $3, yylex), $<p>1, $3.Finish) }
+    | exprmaplist COMMA STRING COLON NEWLINE expr { $$ = setFinish(appendMap($1, $3, $6, yylex), $6.Finish) }
+    | exprmaplist COMMA STRING COLON expr { $$ = setFinish(appendMap($1, $3, $5, yylex), $5.Finish) }
     ;   
 
 object
-    : STRING COLON exprobj { $$ = newObj($1, $3, yylex); }
-    | IDENT COLON exprobj { $$ = newObj($1, $3, yylex); }
-    | object COMMA STRING COLON exprobj { $$ = appendObj($1, $3, $5, yylex);}
-    | object COMMA IDENT COLON exprobj { $$ = appendObj($1, $3, $5, yylex);}
+    : STRING COLON exprobj { $$ = setRange(newObj($1, $3, yylex), $<p>1, $3.Finish) }
+    | IDENT COLON exprobj { $$ = setRange(newObj($1, $3, yylex), $<p>1, $3.Finish) }
+    | object COMMA STRING COLON exprobj { $$ = setFinish(appendObj($1, $3, $5, yylex), $5.Finish)}
+    | object COMMA IDENT COLON exprobj { $$ = setFinish(appendObj($1, $3, $5, yylex), $5.Finish)}
     ;   
 
 objlist
-    : exprobj { $$ = newObjArr($1, yylex); }
-    | objlist COMMA exprobj { $$ = appendObjArr($1, $3, yylex);}
+    : exprobj { $$ = setRange(newObjArr($1, yylex), $1.Begin, $1.Finish) }
+    | objlist COMMA exprobj { $$ = setFinish(appendObjArr($1, $3, yylex), $3.Finish)}
     ;   
 
 exprobj
     : LPAREN expr RPAREN { $$ = $2; }
-    | INT { $$ = newValue($1, yylex);}
-    | FLOAT { $$ = newValue($1, yylex);}
-    | STRING { $$ = newValue($1, yylex);}
-    | QSTRING { $$ = newValue($1, yylex);}
-    | TRUE { $$ = newValue(true, yylex);}
-    | FALSE { $$ = newValue(false, yylex);}
-    | CALL params RPAREN { $$ = newCallFunc($1, $2, yylex);}
-    | CALLCONTRACT cntparams RPAREN { $$ = newCallContract($1, $2, yylex);}
+    | INT { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}
+    | FLOAT { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}
+    | STRING { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}
+    | QSTRING { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}
+    | ISTRING { $$ = newInterp($1, $<p>1, $<e>1, yylex)}
+    | TRUE { $$ = setRange(newValue(true, yylex), $<p>1, $<e>1)}
+    | FALSE { $$ = setRange(newValue(false, yylex), $<p>1, $<e>1)}
+    | CALL params RPAREN { $$ = setRange(newCallFunc($1, $2, yylex), $<p>1, $<e>3)}
+    | CALLCONTRACT cntparams RPAREN { $$ = setRange(newCallContract($1, $2, yylex), $<p>1, $<e>3)}
     | index { $$ = $1}
-    | ENV { $$ = newEnv($1, yylex);}
-    | IDENT { $$ = newGetVar($1, yylex);}
-    | LBRACE object RBRACE { $$ = $2;}
-    | LBRACKET objlist RBRACKET { $$ = $2;}
-    | LBRACKET object RBRACKET { $$ = newObjList($2, yylex);}
+    | slice { $$ = $1}
+    | ENV { $$ = setRange(newEnv($1, yylex), $<p>1, $<e>1)}
+    | IDENT { $$ = setRange(newGetVar($1, yylex), $<p>1, $<e>1)}
+    | LBRACE object RBRACE { $$ = setRange($2, $<p>1, $<e>3)}
+    | LBRACKET objlist RBRACKET { $$ = setRange($2, $<p>1, $<e>3)}
+    | LBRACKET object RBRACKET { $$ = setRange(newObjList($2, yylex), $<p>1, $<e>3)}
 
 
 // 表达式
 expr
     : LPAREN expr RPAREN { $$ = $2; }	// ( 表达式 )
-    | INT { $$ = newValue($1, yylex);}	// 整数
-    | FLOAT { $$ = newValue($1, yylex);}	// 浮点数
-    | STRING { $$ = newValue($1, yylex);}	// "字符串"
-    | QSTRING { $$ = newValue($1, yylex);}	// `字符串`
-    | TRUE { $$ = newValue(true, yylex);}	// true
-    | FALSE { $$ = newValue(false, yylex);}	// false
-    | CALL params RPAREN { $$ = newCallFunc($1, $2, yylex);}	// xxxx( 参数表达式 )
-    | CALLCONTRACT cntparams RPAREN { $$ = newCallContract($1, $2, yylex);}	// @xxx(key1: 表达式, key2: 表达式)
+    | INT { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}	// 整数
+    | FLOAT { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}	// 浮点数
+    | STRING { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}	// "字符串"
+    | QSTRING { $$ = setRange(newValue($1, yylex), $<p>1, $<e>1)}	// `字符串`
+    | ISTRING { $$ = newInterp($1, $<p>1, $<e>1, yylex)}	// "字符串${表达式}"
+    | TRUE { $$ = setRange(newValue(true, yylex), $<p>1, $<e>1)}	// true
+    | FALSE { $$ = setRange(newValue(false, yylex), $<p>1, $<e>1)}	// false
+    | CALL params RPAREN { $$ = setRange(newCallFunc($1, $2, yylex), $<p>1, $<e>3)}	// xxxx( 参数表达式 )
+    | CALLCONTRACT cntparams RPAREN { $$ = setRange(newCallContract($1, $2, yylex), $<p>1, $<e>3)}	// @xxx(key1: 表达式, key2: 表达式)
     | index { $$ = $1}	// xxx[表达式]
-    | ENV { $$ = newEnv($1, yylex);}	// $env1
-    | IDENT { $$ = newGetVar($1, yylex);}	// 变量: xxx
-    | OBJ object RBRACE { $$ = $2;}	// @{
-    | LBRACE exprlist RBRACE { $$ = $2;}
-    | LBRACE exprmaplist RBRACE { $$ = $2;}
-    | QUESTION LPAREN expr COMMA expr COMMA expr RPAREN { $$ = newQuestion($3, $5, $7, yylex);}
-    | expr MUL expr { $$ = newBinary($1, $3, MUL, yylex); }
-    | expr DIV expr { $$ = newBinary($1, $3, DIV, yylex);  }
-    | expr ADD expr { $$ = newBinary($1, $3, ADD, yylex); }
-    | expr SUB expr { $$ = newBinary($1, $3, SUB, yylex);}
-    | expr MOD expr { $$ = newBinary($1, $3, MOD, yylex); } 
-    | expr AND expr { $$ = newBinary($1, $3, AND, yylex); }
-    | expr OR expr { $$ = newBinary($1, $3, OR, yylex);  }
-    | expr EQ expr { $$ = newBinary($1, $3, EQ, yylex); }
-    | expr NOT_EQ expr { $$ = newBinary($1, $3, NOT_EQ, yylex);}
-    | expr LTE expr { $$ = newBinary($1, $3, LTE, yylex); }
-    | expr GTE expr { $$ = newBinary($1, $3, GTE, yylex);  }
-    | expr LT expr { $$ = newBinary($1, $3, LT, yylex); }
-    | expr GT expr { $$ = newBinary($1, $3, GT, yylex);}
-
-    | SUB expr %prec UNARYMINUS { $$ = newUnary($2, SUB, yylex)}
-    | NOT expr %prec UNARYNOT { $$ = newUnary($2, NOT, yylex)}
+    | slice { $$ = $1}	// xxx[1:2]
+    | field { $$ = $1 }	// xxx.yyy
+    | STRUCTVALUE cntparams RBRACE { $$ = setRange(newStructValue($1, $2, yylex), $<p>1, $<e>3) }	// Xxx{key1: 表达式, key2: 表达式}
+    | STRUCTVALUE cntparams NEWLINE RBRACE { $$ = setRange(newStructValue($1, $2, yylex), $<p>1, $<e>4) }
+    | ENV { $$ = setRange(newEnv($1, yylex), $<p>1, $<e>1)}	// $env1
+    | IDENT { $$ = setRange(newGetVar($1, yylex), $<p>1, $<e>1)}	// 变量: xxx
+    | OBJ object RBRACE { $$ = setRange($2, $<p>1, $<e>3)}	// @{
+    | LBRACE exprlist RBRACE { $$ = setRange($2, $<p>1, $<e>3)}
+    | LBRACE exprmaplist RBRACE { $$ = setRange($2, $<p>1, $<e>3)}
+    | QUESTION LPAREN expr COMMA expr COMMA expr RPAREN { $$ = setRange(newQuestion($3, $5, $7, yylex), $<p>1, $<e>8)}
+    | expr MUL expr { $$ = setRange(newBinary($1, $3, MUL, yylex), $<p>1, $3.Finish) }
+    | expr DIV expr { $$ = setRange(newBinary($1, $3, DIV, yylex), $<p>1, $3.Finish) }
+    | expr ADD expr { $$ = setRange(newBinary($1, $3, ADD, yylex), $<p>1, $3.Finish) }
+    | expr SUB expr { $$ = setRange(newBinary($1, $3, SUB, yylex), $<p>1, $3.Finish) }
+    | expr MOD expr { $$ = setRange(newBinary($1, $3, MOD, yylex), $<p>1, $3.Finish) } 
+    | expr BIT_AND expr { $$ = setRange(newBinary($1, $3, BIT_AND, yylex), $<p>1, $3.Finish) }
+    | expr BIT_OR expr { $$ = setRange(newBinary($1, $3, BIT_OR, yylex), $<p>1, $3.Finish) }
+    | expr BIT_XOR expr { $$ = setRange(newBinary($1, $3, BIT_XOR, yylex), $<p>1, $3.Finish) }
+    | expr LSHIFT expr { $$ = setRange(newBinary($1, $3, LSHIFT, yylex), $<p>1, $3.Finish) }
+    | expr RSHIFT expr { $$ = setRange(newBinary($1, $3, RSHIFT, yylex), $<p>1, $3.Finish) }
+    | expr AND expr { $$ = setRange(newBinary($1, $3, AND, yylex), $<p>1, $3.Finish) }
+    | expr OR expr { $$ = setRange(newBinary($1, $3, OR, yylex), $<p>1, $3.Finish) }
+    | expr EQ expr { $$ = setRange(newBinary($1, $3, EQ, yylex), $<p>1, $3.Finish) }
+    | expr NOT_EQ expr { $$ = setRange(newBinary($1, $3, NOT_EQ, yylex), $<p>1, $3.Finish) }
+    | expr LTE expr { $$ = setRange(newBinary($1, $3, LTE, yylex), $<p>1, $3.Finish) }
+    | expr GTE expr { $$ = setRange(newBinary($1, $3, GTE, yylex), $<p>1, $3.Finish) }
+    | expr LT expr { $$ = setRange(newBinary($1, $3, LT, yylex), $<p>1, $3.Finish) }
+    | expr GT expr { $$ = setRange(newBinary($1, $3, GT, yylex), $<p>1, $3.Finish) }
+
+    | SUB expr %prec UNARYMINUS { $$ = setRange(newUnary($2, SUB, yylex), $<p>1, $2.Finish) }
+    | NOT expr %prec UNARYNOT { $$ = setRange(newUnary($2, NOT, yylex), $<p>1, $2.Finish) }
+    | BIT_NOT expr %prec UNARYNOT { $$ = setRange(newUnary($2, BIT_NOT, yylex), $<p>1, $2.Finish) }
     ;
 
 ident_list
     : IDENT { $$ = []string{$1} }
-    | ident_list IDENT { $$ = append($1, $2) }
+    | ident_list IDENT { $$ = append($1, $2); $<e>$ = $<e>2 }
     ;
 
 par_declaration
     : type ident_list { $$ = newVars($1, $2)}
     ;
 
+struct_fields
+    : /*empty*/ { $$ = nil }
+    | struct_fields NEWLINE { $$ = $1 }
+    | struct_fields par_declaration NEWLINE { $$ = append($1, $2...) }
+    ;
+
+// 最后一个字段之后可以没有换行
+struct_body
+    : struct_fields { $$ = $1 }
+    | struct_fields par_declaration { $$ = append($1, $2...) }
+    ;
+
 par_declarations
     : /*empty*/ {$$=nil}
     | par_declaration { $$ = $1}
@@ -335,23 +480,30 @@ par_declarations
 
 var_declaration
     : type ident_list { $$ = newVars($1, $2) }
-    | type IDENT ASSIGN expr { $$ = newVarExp($1, $2, $4, yylex) }
+    | type ident_list STRING { $$ = setAttr(newVars($1, $2), $3) }	// int a b "required,min=0"
+    | type ident_list QSTRING { $$ = setAttr(newVars($1, $2), $3) }	// str a `regex=^\d+$`
+    | type IDENT ASSIGN expr {
+        $$ = newVarExp($1, $2, $4, yylex)
+        setRange($$[0].Exp, $<p>1, $4.Finish)
+        setRange($$[0].Exp.Value.(*NBinary).Left, $<p>2, $<e>2)
+    }
     ;
 
 var_declarations
-    : var_declaration { $$=$1 }
-    | var_declarations NEWLINE var_declaration { $$ = append($1, $3...) }
-    ;
-
-contract_data 
     : /*empty*/ { $$ = nil }
-    | DATA LBRACE NEWLINE var_declarations NEWLINE RBRACE NEWLINE { $$ = $4 }
+    | var_declarations NEWLINE { $$ = $1 }	// 空行
+    | var_declarations var_declaration NEWLINE { $$ = append($1, $2...) }
     ;
 
-// 合约主体
+// 合约主体, data之前只允许空行和注释
 contract_body
-    : contract_data statements {	// 合约data 和 语句列表
-        $$ = newBlock($1, $2, yylex)
+    : statements { $$ = newBlock(nil, $1, yylex) }	// 语句列表
+    | statements DATA LBRACE var_declarations RBRACE NEWLINE statements {	// 合约data 和 语句列表
+        if len($1.Value.(*NBlock).Statements) > 0 {
+            yylex.Error(errDataFirst)
+        }
+        $$ = newBlock($4, $7, yylex)
+        setData(yylex, $$, $<p>2, $<p>5)
     }
     ;
 
@@ -364,7 +516,7 @@ contract_read
 // 合约声明
 contract_declaration
     : CONTRACT IDENT contract_read LBRACE NEWLINE contract_body RBRACE { // contract xxx read {换行 合约主体 }
-        $$ = newContract($2, $3, $6, yylex)
+        $$ = setRange(newContract($2, $3, $<b>1, setRange($6, $<p>4, $<e>7), yylex), $<p>1, $<e>7)
         setResult(yylex, $$)
         }
     | contract_declaration NEWLINE	// 递归定义
@@ -0,0 +1,667 @@
+package parser
+
+import (
+	"bytes"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
+const indentSpaces = `    `
+
+var (
+	typeNames = map[int64]string{
+		VInt:    `int`,
+		VBool:   `bool`,
+		VStr:    `str`,
+		VArr:    `arr`,
+		VMap:    `map`,
+		VFloat:  `float`,
+		VMoney:  `money`,
+		VObject: `obj`,
+		VBytes:  `bytes`,
+		VFile:   `file`,
+	}
+	operators = map[int]string{
+		ADD:        `+`,
+		SUB:        `-`,
+		MUL:        `*`,
+		DIV:        `/`,
+		MOD:        `%`,
+		AND:        `&&`,
+		OR:         `||`,
+		EQ:         `==`,
+		NOT_EQ:     `!=`,
+		NOT:        `!`,
+		LT:         `<`,
+		GT:         `>`,
+		LTE:        `<=`,
+		GTE:        `>=`,
+		ASSIGN:     `=`,
+		ADD_ASSIGN: `+=`,
+		SUB_ASSIGN: `-=`,
+		MUL_ASSIGN: `*=`,
+		DIV_ASSIGN: `/=`,
+		MOD_ASSIGN: `%=`,
+		INC:        `++`,
+		DEC:        `--`,
+		BIT_AND:    `&`,
+		BIT_OR:     `|`,
+		BIT_XOR:    `^`,
+		BIT_NOT:    `~`,
+		LSHIFT:     `<<`,
+		RSHIFT:     `>>`,
+
+		AND_ASSIGN:    `&=`,
+		OR_ASSIGN:     `|=`,
+		XOR_ASSIGN:    `^=`,
+		LSHIFT_ASSIGN: `<<=`,
+		RSHIFT_ASSIGN: `>>=`,
+	}
+	// the keywords can't be used as keys of objects without quotes
+	keywords = map[string]bool{
+		`break`: true, `continue`: true, `data`: true, `contract`: true, `while`: true, `if`: true,
+		`elif`: true, `else`: true, `return`: true, `true`: true, `false`: true, `func`: true,
+		`for`: true, `in`: true, `switch`: true, `case`: true, `read`: true, `default`: true,
+		`bool`: true, `int`: true, `str`: true, `arr`: true, `map`: true, `float`: true,
+		`money`: true, `obj`: true, `bytes`: true, `file`: true, `hexint`: true, `library`: true,
+		`import`: true, `conditions`: true, `action`: true,
+		`try`: true, `catch`: true,
+	}
+)
+
+// Precedence of operators as in parser.y
+const (
+	precAnd = iota + 1
+	precOr
+	precCompare
+	precAdd
+	precMul
+	precUnary
+	precPrimary
+)
+
+const errInvalidType = `%d:%d: invalid type`
+
+type printer struct {
+	buf    bytes.Buffer
+	indent int
+	err    error
+}
+
+// Format returns the source of the contract in the canonical form. The comments which
+// have been attached to the nodes are kept.
+func Format(root *Node) (string, error) {
+	var p printer
+	p.contract(root)
+	if p.err != nil {
+		return ``, p.err
+	}
+	return p.buf.String(), nil
+}
+
+// FormatSource parses the source of the contracts and returns it in the canonical form.
+// The contracts are separated by the empty line.
+func FormatSource(input string) (string, error) {
+	lines := strings.Split(input, "\n")
+	for i, line := range lines {
+		lines[i] = strings.TrimRight(line, "\r")
+	}
+	// the lexer expects \r\n at the end of lines
+	roots, err := ParseFile(``, strings.Join(lines, "\r\n"))
+	if err != nil {
+		return ``, err
+	}
+	out := make([]string, len(roots))
+	for i, root := range roots {
+		if out[i], err = Format(root); err != nil {
+			return ``, err
+		}
+	}
+	return strings.Join(out, "\n"), nil
+}
+
+func (p *printer) line(s string) {
+	if len(s) > 0 {
+		p.buf.WriteString(strings.Repeat(indentSpaces, p.indent))
+	}
+	p.buf.WriteString(s)
+	p.buf.WriteByte('\n')
+}
+
+// leading prints the leading comments of the node
+func (p *printer) leading(node *Node) {
+	for _, c := range node.Comments {
+		if !c.Trailing && !c.Inline {
+			p.line(c.Text)
+		}
+	}
+}
+
+// trailing prints the trailing comments of the node. The comment on the last line of
+// the node is appended to the last printed line.
+func (p *printer) trailing(node *Node, last int) {
+	for _, c := range node.Comments {
+		if !c.Trailing {
+			continue
+		}
+		if c.Line == last {
+			p.buf.Truncate(p.buf.Len() - 1)
+			p.buf.WriteString(` ` + c.Text + "\n")
+		} else {
+			p.line(c.Text)
+		}
+	}
+}
+
+// firstLine returns the line of the first leading comment or the line of the statement
+func firstLine(node *Node) int {
+	for _, c := range node.Comments {
+		if !c.Trailing && !c.Inline {
+			return c.Line
+		}
+	}
+	return node.Begin.Line
+}
+
+// lastLine returns the line of the last trailing comment or the last line of the statement
+func lastLine(node *Node) int {
+	line := node.Finish.Line
+	for _, c := range node.Comments {
+		if end := c.Line + strings.Count(c.Text, "\n"); c.Trailing && end > line {
+			line = end
+		}
+	}
+	return line
+}
+
+func (p *printer) contract(root *Node) {
+	nContract := root.Value.(*NContract)
+	p.leading(root)
+	header := `contract ` + nContract.Name
+	if nContract.Library {
+		header = `library ` + nContract.Name
+	}
+	if nContract.Read {
+		header += ` read`
+	}
+	p.line(header + ` {`)
+	p.indent++
+	block := nContract.Block
+	p.leading(block)
+	nBlock := block.Value.(*NBlock)
+	if len(nBlock.Params) > 0 {
+		p.data(nBlock.Params)
+		if len(nBlock.Statements) > 0 {
+			p.line(``)
+		}
+	}
+	p.statements(block)
+	p.indent--
+	p.line(`}`)
+	p.trailing(root, -1)
+}
+
+// data prints data block. The variables with the same type node are printed in one line.
+func (p *printer) data(params []NVar) {
+	p.line(`data {`)
+	p.indent++
+	for i := 0; i < len(params); {
+		vtype := params[i].Type
+		p.leading(vtype)
+		names := []string{params[i].Name}
+		if params[i].Exp != nil {
+			p.line(p.typeName(vtype) + ` ` + params[i].Name + ` = ` +
+				p.expr(params[i].Exp.Value.(*NBinary).Right))
+			i++
+		} else {
+			attr := params[i].Attr
+			for i++; i < len(params) && params[i].Type == vtype && params[i].Attr == attr; i++ {
+				names = append(names, params[i].Name)
+			}
+			if len(attr) > 0 {
+				names = append(names, quote(attr))
+			}
+			p.line(p.typeName(vtype) + ` ` + strings.Join(names, ` `))
+		}
+		p.trailing(vtype, vtype.Begin.Line)
+	}
+	p.indent--
+	p.line(`}`)
+}
+
+// statements prints the statements of the block. The single empty lines between
+// statements are kept.
+func (p *printer) statements(block *Node) {
+	if block == nil {
+		return
+	}
+	for i, stmt := range block.Value.(*NBlock).Statements {
+		if i > 0 && firstLine(stmt) > lastLine(block.Value.(*NBlock).Statements[i-1])+1 {
+			p.line(``)
+		}
+		p.leading(stmt)
+		p.statement(stmt)
+		p.trailing(stmt, stmt.Finish.Line)
+	}
+	p.trailing(block, -1)
+}
+
+// body prints the statements of the block with the closing brace
+func (p *printer) body(block *Node, closing string) {
+	p.indent++
+	p.statements(block)
+	p.indent--
+	p.line(closing)
+}
+
+func (p *printer) statement(node *Node) {
+	switch node.Type {
+	case TIf:
+		nIf := node.Value.(*NIf)
+		p.line(`if ` + p.expr(nIf.Cond) + ` {`)
+		body := nIf.IfBody
+		if nIf.ElifBody != nil {
+			for _, item := range nIf.ElifBody.Value.(*NElif).List {
+				p.body(body, `} elif `+p.expr(item.Cond)+` {`)
+				body = item.Body
+			}
+		}
+		if nIf.ElseBody != nil {
+			p.body(body, `} else {`)
+			body = nIf.ElseBody
+		}
+		p.body(body, `}`)
+	case TWhile:
+		nWhile := node.Value.(*NWhile)
+		p.line(`while ` + p.expr(nWhile.Cond) + ` {`)
+		p.body(nWhile.Body, `}`)
+	case TFor:
+		nFor := node.Value.(*NFor)
+		vars := nFor.VarName
+		if len(nFor.KeyName) > 0 {
+			vars += `, ` + nFor.KeyName
+		}
+		p.line(`for ` + vars + ` in ` + p.expr(nFor.Expr) + ` {`)
+		p.body(nFor.Body, `}`)
+	case TForInt:
+		nFor := node.Value.(*NForInt)
+		p.line(`for ` + nFor.VarName + ` in ` + p.expr(nFor.From) + `..` + p.expr(nFor.To) + ` {`)
+		p.body(nFor.Body, `}`)
+	case TFunc:
+		nFunc := node.Value.(*NFunc)
+		var pars []string
+		for i := 0; i < len(nFunc.Params); {
+			vtype := nFunc.Params[i].Type
+			names := []string{nFunc.Params[i].Name}
+			for i++; i < len(nFunc.Params) && nFunc.Params[i].Type == vtype; i++ {
+				names = append(names, nFunc.Params[i].Name)
+			}
+			pars = append(pars, p.typeName(vtype)+` `+strings.Join(names, ` `))
+		}
+		header := `func ` + nFunc.Name + `(` + strings.Join(pars, `, `) + `)`
+		if nFunc.Result != nil {
+			header += ` ` + p.typeName(nFunc.Result)
+		} else if len(nFunc.Results) > 0 {
+			results := make([]string, len(nFunc.Results))
+			for i, item := range nFunc.Results {
+				results[i] = p.typeName(item)
+			}
+			header += ` (` + strings.Join(results, `, `) + `)`
+		}
+		p.line(header + ` {`)
+		p.body(nFunc.Body, `}`)
+	case TSwitch:
+		nSwitch := node.Value.(*NSwitch)
+		p.line(`switch ` + p.expr(nSwitch.Expr))
+		if nSwitch.Case != nil {
+			for _, item := range nSwitch.Case.Value.(*NCase).List {
+				p.line(`case ` + p.list(item.ExprList.Value.(*NArray).List) + ` {`)
+				p.body(item.Body, `}`)
+			}
+		}
+		if nSwitch.Default != nil {
+			p.line(`default {`)
+			p.body(nSwitch.Default, `}`)
+		}
+	case TImport:
+		p.line(`import ` + node.Value.(*NImport).Name)
+	case TConditions:
+		p.line(`conditions {`)
+		p.body(node.Value.(*NSection).Body, `}`)
+	case TAction:
+		p.line(`action {`)
+		p.body(node.Value.(*NSection).Body, `}`)
+	case TTry:
+		nTry := node.Value.(*NTry)
+		p.line(`try {`)
+		p.body(nTry.Body, `} catch `+nTry.Var+` {`)
+		p.body(nTry.Catch, `}`)
+	case TStruct:
+		nStruct := node.Value.(*NStruct)
+		p.line(`type ` + nStruct.Name + ` struct {`)
+		p.indent++
+		for i := 0; i < len(nStruct.Fields); {
+			vtype := nStruct.Fields[i].Type
+			names := []string{nStruct.Fields[i].Name}
+			for i++; i < len(nStruct.Fields) && nStruct.Fields[i].Type == vtype; i++ {
+				names = append(names, nStruct.Fields[i].Name)
+			}
+			p.line(p.typeName(vtype) + ` ` + strings.Join(names, ` `))
+		}
+		p.indent--
+		p.line(`}`)
+	case TMultiAssign:
+		nAssign := node.Value.(*NMultiAssign)
+		p.line(p.list(nAssign.Vars) + ` = ` + p.expr(nAssign.Expr))
+	case TBreak:
+		p.line(`break`)
+	case TContinue:
+		p.line(`continue`)
+	case TReturn:
+		nReturn := node.Value.(*NReturn)
+		if len(nReturn.List) > 0 {
+			p.line(`return ` + p.list(nReturn.List))
+		} else if nReturn.Expr != nil {
+			p.line(`return ` + p.expr(nReturn.Expr))
+		} else {
+			p.line(`return`)
+		}
+	case TVars:
+		p.line(p.vars(node))
+	case TBinary:
+		nBinary := node.Value.(*NBinary)
+		var left string
+		if nBinary.Left.Type == TVars {
+			left = p.vars(nBinary.Left)
+		} else {
+			left = p.expr(nBinary.Left)
+		}
+		if nBinary.Oper == INC || nBinary.Oper == DEC {
+			p.line(left + operators[nBinary.Oper])
+			break
+		}
+		p.line(left + ` ` + operators[nBinary.Oper] + ` ` + p.expr(nBinary.Right))
+	default:
+		p.line(p.expr(node))
+	}
+}
+
+// vars returns the declaration of variables
+func (p *printer) vars(node *Node) string {
+	list := node.Value.(*NVars).Vars
+	names := make([]string, len(list))
+	for i, item := range list {
+		names[i] = item.Name
+	}
+	return p.typeName(list[0].Type) + ` ` + strings.Join(names, ` `)
+}
+
+// typeName returns the name of the type
+func (p *printer) typeName(node *Node) string {
+	nType := node.Value.(*NType)
+	if nType.Type == VVoid && p.err == nil {
+		p.err = fmt.Errorf(errInvalidType, node.Begin.Line, node.Begin.Column)
+	}
+	return nType.String()
+}
+
+// String returns the name of the type. The default subtype str is omitted.
+func (nType *NType) String() string {
+	if len(nType.Name) > 0 {
+		return nType.Name
+	}
+	var names []string
+	for itype := nType.Type; itype != 0; itype >>= 4 {
+		if itype&0xf == VStruct {
+			names = append(names, `struct`)
+			break
+		}
+		names = append(names, typeNames[itype&0xf])
+	}
+	if nType.Def && len(names) > 1 {
+		names = names[:len(names)-1]
+	}
+	return strings.Join(names, `.`)
+}
+
+func precedence(node *Node) int {
+	switch node.Type {
+	case TBinary:
+		switch node.Value.(*NBinary).Oper {
+		case AND:
+			return precAnd
+		case OR:
+			return precOr
+		case ADD, SUB, BIT_OR, BIT_XOR:
+			return precAdd
+		case MUL, DIV, MOD, BIT_AND, LSHIFT, RSHIFT:
+			return precMul
+		default:
+			return precCompare
+		}
+	case TUnary:
+		return precUnary
+	case TValue:
+		switch v := node.Value.(type) {
+		case int64:
+			if v < 0 {
+				return precUnary
+			}
+		case float64:
+			if v < 0 {
+				return precUnary
+			}
+		}
+	}
+	return precPrimary
+}
+
+// operand returns the expression in parentheses if its precedence is less than prec
+func (p *printer) operand(node *Node, prec int) string {
+	if precedence(node) < prec {
+		return `(` + p.expr(node) + `)`
+	}
+	return p.expr(node)
+}
+
+// logical returns the operator of && and || expressions
+func logical(node *Node) int {
+	if node.Type == TBinary {
+		if oper := node.Value.(*NBinary).Oper; oper == AND || oper == OR {
+			return oper
+		}
+	}
+	return 0
+}
+
+func (p *printer) list(list []*Node) string {
+	items := make([]string, len(list))
+	for i, item := range list {
+		items[i] = p.expr(item)
+	}
+	return strings.Join(items, `, `)
+}
+
+// expr returns the expression with the inline comments before it
+func (p *printer) expr(node *Node) string {
+	var prefix string
+	for _, c := range node.Comments {
+		if c.Inline {
+			prefix += c.Text + ` `
+		}
+	}
+	return prefix + p.operandText(node)
+}
+
+func (p *printer) operandText(node *Node) string {
+	switch node.Type {
+	case TValue:
+		return value(node.Value)
+	case TGetVar, TSetVar:
+		return node.Value.(*NVarValue).Name
+	case TEnv:
+		return `$` + node.Value.(*NEnv).Name
+	case TGetIndex, TSetIndex:
+		nIndex := node.Value.(*NGetIndex)
+		ret := nIndex.Name
+		for _, index := range nIndex.Indexes {
+			ret += `[` + p.expr(index) + `]`
+		}
+		return ret
+	case TBinary:
+		nBinary := node.Value.(*NBinary)
+		left, right := precedence(node), precedence(node)+1
+		// && has lower precedence than || so the mixed logical operators are always in parentheses
+		if oper := logical(node); oper != 0 {
+			if logical(nBinary.Left) != 0 && logical(nBinary.Left) != oper {
+				left = precPrimary
+			}
+			if logical(nBinary.Right) != 0 && logical(nBinary.Right) != oper {
+				right = precPrimary
+			}
+		}
+		return p.operand(nBinary.Left, left) + ` ` + operators[nBinary.Oper] + ` ` +
+			p.operand(nBinary.Right, right)
+	case TUnary:
+		nUnary := node.Value.(*NUnary)
+		return operators[nUnary.Oper] + p.operand(nUnary.Operand, precUnary)
+	case TQuestion:
+		nQuestion := node.Value.(*NQuestion)
+		return `?(` + p.list([]*Node{nQuestion.Cond, nQuestion.Left, nQuestion.Right}) + `)`
+	case TCallFunc:
+		nCall := node.Value.(*NCallFunc)
+		if len(nCall.Interp) > 0 {
+			return nCall.Interp
+		}
+		var pars string
+		if nCall.Params != nil {
+			pars = p.list(nCall.Params.Value.(*NParams).Expr)
+		}
+		return nCall.Name + `(` + pars + `)`
+	case TCallContract:
+		nCall := node.Value.(*NCallContract)
+		pars := make([]string, len(nCall.Params))
+		for i, par := range nCall.Params {
+			pars[i] = par.Name + `: ` + p.expr(par.Expr)
+		}
+		return `@` + VersionName(nCall.Name, nCall.Version) + `(` + strings.Join(pars, `, `) + `)`
+	case TArray:
+		return `{` + p.list(node.Value.(*NArray).List) + `}`
+	case TSlice:
+		nSlice := node.Value.(*NSlice)
+		var from, to string
+		if nSlice.From != nil {
+			from = p.expr(nSlice.From)
+		}
+		if nSlice.To != nil {
+			to = p.expr(nSlice.To)
+		}
+		return p.expr(nSlice.Expr) + `[` + from + `:` + to + `]`
+	case TField:
+		nField := node.Value.(*NField)
+		if precedence(nField.Expr) < precPrimary {
+			return `(` + p.expr(nField.Expr) + `).` + nField.Name
+		}
+		return p.expr(nField.Expr) + `.` + nField.Name
+	case TStructValue:
+		nValue := node.Value.(*NStructValue)
+		fields := make([]string, len(nValue.Fields))
+		for i, field := range nValue.Fields {
+			fields[i] = field.Name + `: ` + p.expr(field.Expr)
+		}
+		return nValue.Name + `{` + strings.Join(fields, `, `) + `}`
+	case TMap:
+		list := node.Value.(*NMap).List
+		items := make([]string, len(list))
+		for i, item := range list {
+			items[i] = quote(item.Key) + `: ` + p.expr(item.Value)
+		}
+		return `{` + strings.Join(items, `, `) + `}`
+	case TObject:
+		return `@{` + p.object(node) + `}`
+	}
+	return ``
+}
+
+// object returns the keys and values of the object
+func (p *printer) object(node *Node) string {
+	list := node.Value.(*NObject).List
+	items := make([]string, len(list))
+	for i, item := range list {
+		key := item.Key
+		if !isIdent(key) {
+			key = quote(key)
+		}
+		items[i] = key + `: ` + p.exprObj(item.Value)
+	}
+	return strings.Join(items, `, `)
+}
+
+// exprObj returns the value of the object. Only simple expressions can be used without
+// parentheses.
+func (p *printer) exprObj(node *Node) string {
+	switch node.Type {
+	case TObject:
+		return `{` + p.object(node) + `}`
+	case TObjList:
+		return `[` + p.object(node.Value.(*NObjList).Obj) + `]`
+	case TObjArr:
+		list := node.Value.(*NObjArr).List
+		items := make([]string, len(list))
+		for i, item := range list {
+			items[i] = p.exprObj(item)
+		}
+		return `[` + strings.Join(items, `, `) + `]`
+	case TGetVar, TGetIndex, TSlice, TEnv, TCallFunc, TCallContract:
+		return p.expr(node)
+	case TValue:
+		if precedence(node) == precPrimary {
+			return p.expr(node)
+		}
+	}
+	return `(` + p.expr(node) + `)`
+}
+
+func isIdent(s string) bool {
+	if len(s) == 0 || keywords[s] {
+		return false
+	}
+	for i, r := range s {
+		if r != '_' && (r < 'a' || r > 'z') && (r < 'A' || r > 'Z') && (i == 0 || r < '0' || r > '9') {
+			return false
+		}
+	}
+	return true
+}
+
+func value(v interface{}) string {
+	switch val := v.(type) {
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		ret := strconv.FormatFloat(val, 'f', -1, 64)
+		if !strings.Contains(ret, `.`) {
+			ret += `.0`
+		}
+		return ret
+	case bool:
+		return strconv.FormatBool(val)
+	case string:
+		return quote(val)
+	}
+	return ``
+}
+
+// quote returns the string literal. The raw string is used if the string contains
+// quotes, backslashes, line breaks or ${.
+func quote(s string) string {
+	if strings.Contains(s, `${`) && !strings.Contains(s, "`") {
+		return "`" + s + "`"
+	}
+	if (strings.ContainsAny(s, "\"\\") || strings.Contains(s, "\r\n")) && !strings.Contains(s, "`") &&
+		!strings.ContainsAny(strings.Replace(s, "\r\n", ``, -1), "\r\n") {
+		return "`" + strings.Replace(s, "\r\n", "\n", -1) + "`"
+	}
+	return strconv.Quote(s)
+}
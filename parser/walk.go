@@ -0,0 +1,145 @@
+package parser
+
+// Visitor is used by Walk. The Visit method is invoked for each node. If the result
+// visitor w is not nil, Walk visits each of the children of the node with the visitor w,
+// followed by a call of w.Visit(nil).
+type Visitor interface {
+	Visit(node *Node) (w Visitor)
+}
+
+// Walk traverses AST in depth-first order. The children are visited in the source order.
+func Walk(v Visitor, node *Node) {
+	if node == nil {
+		return
+	}
+	if v = v.Visit(node); v == nil {
+		return
+	}
+	for _, child := range Children(node) {
+		Walk(v, child)
+	}
+	v.Visit(nil)
+}
+
+type inspector func(*Node) bool
+
+func (f inspector) Visit(node *Node) Visitor {
+	if f(node) {
+		return f
+	}
+	return nil
+}
+
+// Inspect traverses AST in depth-first order. It calls f(node) for each node, if f returns
+// true then Inspect visits the children of the node, followed by a call of f(nil).
+func Inspect(node *Node, f func(*Node) bool) {
+	Walk(inspector(f), node)
+}
+
+// typeNodes returns the type nodes of the variables. The variables declared in one group
+// share the type node which is returned once.
+func typeNodes(vars []NVar) []*Node {
+	var ret []*Node
+	for i, item := range vars {
+		if i == 0 || item.Type != vars[i-1].Type {
+			ret = append(ret, item.Type)
+		}
+		if item.Exp != nil {
+			ret = append(ret, item.Exp)
+		}
+	}
+	return ret
+}
+
+// Children returns the child nodes in the source order. The empty blocks are skipped.
+func Children(node *Node) []*Node {
+	var list []*Node
+	switch v := node.Value.(type) {
+	case *NContract:
+		list = append(list, v.Block)
+	case *NBlock:
+		list = append(typeNodes(v.Params), v.Statements...)
+	case *NVars:
+		list = typeNodes(v.Vars)
+	case *NBinary:
+		list = append(list, v.Left, v.Right)
+	case *NUnary:
+		list = append(list, v.Operand)
+	case *NQuestion:
+		list = append(list, v.Cond, v.Left, v.Right)
+	case *NIf:
+		list = append(list, v.Cond, v.IfBody, v.ElifBody, v.ElseBody)
+	case *NElif:
+		for _, item := range v.List {
+			list = append(list, item.Cond, item.Body)
+		}
+	case *NWhile:
+		list = append(list, v.Cond, v.Body)
+	case *NFor:
+		list = append(list, v.Expr, v.Body)
+	case *NForInt:
+		list = append(list, v.From, v.To, v.Body)
+	case *NFunc:
+		list = append(append(typeNodes(v.Params), v.Result), v.Results...)
+		list = append(list, v.Body)
+	case *NCallFunc:
+		list = append(list, v.Params)
+	case *NParams:
+		list = v.Expr
+	case *NCallContract:
+		for _, par := range v.Params {
+			list = append(list, par.Expr)
+		}
+	case *NContractParams:
+		for _, par := range v.Params {
+			list = append(list, par.Expr)
+		}
+	case *NSection:
+		list = append(list, v.Body)
+	case *NTry:
+		list = append(list, v.Body, v.Catch)
+	case *NReturn:
+		list = append([]*Node{v.Expr}, v.List...)
+	case *NField:
+		list = append(list, v.Expr)
+	case *NSlice:
+		list = append(list, v.Expr, v.From, v.To)
+	case *NStructValue:
+		for _, field := range v.Fields {
+			list = append(list, field.Expr)
+		}
+	case *NStruct:
+		list = typeNodes(v.Fields)
+	case *NMultiAssign:
+		list = append(append(list, v.Vars...), v.Expr)
+	case *NGetIndex:
+		list = v.Indexes
+	case *NArray:
+		list = v.List
+	case *NMap:
+		for _, item := range v.List {
+			list = append(list, item.Value)
+		}
+	case *NObject:
+		for _, item := range v.List {
+			list = append(list, item.Value)
+		}
+	case *NObjArr:
+		list = v.List
+	case *NObjList:
+		list = append(list, v.Obj)
+	case *NSwitch:
+		list = append(list, v.Expr, v.Case, v.Default)
+	case *NCase:
+		for _, item := range v.List {
+			list = append(list, item.ExprList, item.Body)
+		}
+	}
+	ret := make([]*Node, 0, len(list))
+	for _, child := range list {
+		if child != nil {
+			ret = append(ret, child)
+		}
+	}
+	return ret
+}
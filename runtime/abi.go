@@ -0,0 +1,215 @@
+package runtime
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/types"
+)
+
+const (
+	errABIParam = `Parameter %s must have %s type`
+	errABIType  = `Parameter %s has unsupported type %s`
+)
+
+// abiTypes contains the types of the parameters which can be passed by the host
+var abiTypes = map[string]uint32{
+	`int`: parser.VInt, `bool`: parser.VBool, `str`: parser.VStr, `float`: parser.VFloat,
+	`money`: parser.VMoney, `bytes`: parser.VBytes, `file`: parser.VFile,
+}
+
+// ABIParam describes the data parameter of the contract or the parameter of the function
+type ABIParam struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+	Attr string `json:"attr,omitempty"` // the attributes of the data parameter
+}
+
+// ABIFunc describes the function of the contract. Result is empty if the function
+// doesn't return a value, Results contains the types of the multiple results.
+type ABIFunc struct {
+	Name    string     `json:"name"`
+	Params  []ABIParam `json:"params"`
+	Result  string     `json:"result,omitempty"`
+	Results []string   `json:"results,omitempty"`
+}
+
+// ABIStruct describes the struct type of the contract, the structs are passed as json objects
+type ABIStruct struct {
+	Name   string     `json:"name"`
+	Fields []ABIParam `json:"fields"`
+}
+
+// ABI is the description of the contract for the client applications. The data parameters
+// are sorted in the declaration order, the functions of the imported libraries are skipped.
+type ABI struct {
+	Name    string      `json:"name"`
+	Read    bool        `json:"read"`
+	Library bool        `json:"library,omitempty"`
+	Params  []ABIParam  `json:"params"`
+	Funcs   []ABIFunc   `json:"funcs"`
+	Structs []ABIStruct `json:"structs,omitempty"`
+}
+
+// TypeName returns the name of the type like arr.map.str
+func TypeName(vtype int64) string {
+	return (&parser.NType{Type: vtype}).String()
+}
+
+// ABI returns the description of the data parameters and the functions of the contract
+func (cnt *Contract) ABI() *ABI {
+	abi := &ABI{
+		Name:    cnt.Name,
+		Read:    cnt.Read,
+		Library: cnt.Library,
+		Params:  make([]ABIParam, 0, len(cnt.Params)),
+		Funcs:   make([]ABIFunc, 0, len(cnt.Funcs)),
+	}
+	names := make([]string, 0, len(cnt.Params))
+	for name := range cnt.Params {
+		names = append(names, name)
+	}
+	sort.Slice(names, func(i, j int) bool {
+		return cnt.Params[names[i]].Index < cnt.Params[names[j]].Index
+	})
+	for _, name := range names {
+		vtype := cnt.Params[name].Type
+		if ind := int(cnt.Params[name].Index); ind < len(cnt.VarsList) {
+			vtype = cnt.VarsList[ind].Type
+		}
+		par := ABIParam{Name: name, Type: cnt.StructName(int64(vtype))}
+		if attr := cnt.Attrs[name]; attr != nil {
+			par.Attr = attr.Source
+		}
+		abi.Params = append(abi.Params, par)
+	}
+	for _, finfo := range cnt.Funcs {
+		if strings.IndexByte(finfo.Name, '.') >= 0 {
+			continue
+		}
+		item := ABIFunc{
+			Name:   finfo.Name,
+			Params: make([]ABIParam, len(finfo.Params)),
+			Result: cnt.StructName(finfo.Result),
+		}
+		for i, par := range finfo.Params {
+			item.Params[i] = ABIParam{Name: par.Name, Type: cnt.StructName(par.Type)}
+		}
+		for _, vtype := range finfo.Results {
+			item.Results = append(item.Results, cnt.StructName(vtype))
+		}
+		abi.Funcs = append(abi.Funcs, item)
+	}
+	for _, sinfo := range cnt.Structs {
+		item := ABIStruct{Name: sinfo.Name, Fields: make([]ABIParam, len(sinfo.Fields))}
+		for i, field := range sinfo.Fields {
+			item.Fields[i] = ABIParam{Name: field.Name, Type: cnt.StructName(field.Type)}
+		}
+		abi.Structs = append(abi.Structs, item)
+	}
+	return abi
+}
+
+// findStruct returns the description of the struct type or nil
+func (abi *ABI) findStruct(name string) *ABIStruct {
+	for i := range abi.Structs {
+		if abi.Structs[i].Name == name {
+			return &abi.Structs[i]
+		}
+	}
+	return nil
+}
+
+// checkStruct checks that the json object has only the fields of the struct
+func (abi *ABI) checkStruct(sinfo *ABIStruct, name, input string) error {
+	var v map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return fmt.Errorf(errStructParam, name)
+	}
+	for key := range v {
+		var ok bool
+		for _, field := range sinfo.Fields {
+			if ok = field.Name == key; ok {
+				break
+			}
+		}
+		if !ok {
+			return fmt.Errorf(errStructField, sinfo.Name, key)
+		}
+	}
+	return nil
+}
+
+// CheckParams checks the parameters which are passed by the host before the execution of
+// the contract. The values are checked in the same way as VM.Run converts them, then the
+// attributes of the parameters are checked. The missing optional parameters are skipped,
+// they get the default values.
+func (abi *ABI) CheckParams(data IData) error {
+	for _, par := range abi.Params {
+		var (
+			attr *ParamAttr
+			err  error
+		)
+		vtype := abiTypes[par.Type]
+		if len(par.Attr) > 0 {
+			if attr, err = ParseAttr(par.Attr, vtype); err != nil {
+				return err
+			}
+		}
+		v := data.GetParam(par.Name)
+		if v == nil {
+			if attr != nil && attr.Required {
+				return fmt.Errorf(errParamRequired, par.Name)
+			}
+			continue
+		}
+		switch vVal := v.(type) {
+		case string:
+			switch vtype {
+			case parser.VInt:
+				_, err = strconv.ParseInt(vVal, 10, 64)
+			case parser.VFloat:
+				_, err = strconv.ParseFloat(vVal, 64)
+			case parser.VMoney:
+				_, err = decimal.NewFromString(vVal)
+			case parser.VBytes:
+				_, err = hex.DecodeString(vVal)
+			case parser.VStr, parser.VBool:
+			default:
+				sinfo := abi.findStruct(par.Type)
+				if sinfo == nil {
+					return fmt.Errorf(errABIType, par.Name, par.Type)
+				}
+				if err = abi.checkStruct(sinfo, par.Name, vVal); err != nil {
+					return err
+				}
+			}
+		case []byte:
+			if par.Type != `bytes` {
+				return fmt.Errorf(errABIParam, par.Name, par.Type)
+			}
+		case *types.File:
+			if par.Type != `file` {
+				return fmt.Errorf(errABIParam, par.Name, par.Type)
+			}
+		default:
+			return fmt.Errorf(errABIParam, par.Name, par.Type)
+		}
+		if err != nil {
+			return fmt.Errorf(errABIParam, par.Name, par.Type)
+		}
+		if attr != nil {
+			if err = attr.Check(par.Name, vtype, v); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,195 @@
+package runtime
+
+import (
+	"encoding/hex"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"unicode/utf8"
+	"unsafe"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const (
+	errAttrUnknown   = `Unknown attribute %s`
+	errAttrValue     = `Invalid value of attribute %s`
+	errAttrType      = `Attribute %s is not supported for %s type`
+	errAttrConflict  = `Attributes required and optional cannot be used together`
+	errParamRequired = `Parameter %s is required`
+	errParamMin      = `Parameter %s must be greater than or equal to %s`
+	errParamMax      = `Parameter %s must be less than or equal to %s`
+	errParamMaxLen   = `Parameter %s must not be longer than %d`
+	errParamRegexp   = `Parameter %s doesn't match %s`
+)
+
+// ParamAttr contains the attributes of the data parameter which are checked before the
+// execution of the contract. The parameters are optional by default.
+type ParamAttr struct {
+	Source   string // the attributes like "required,min=0"
+	Required bool
+	Min      *decimal.Decimal
+	Max      *decimal.Decimal
+	MaxLen   int // 0 if the length isn't limited
+	Places   int // the decimal places of money, the value is rounded down to them
+	Regexp   *regexp.Regexp
+}
+
+// ParseAttr parses the attributes of the data parameter. The attributes are separated by
+// commas, regex must be the last attribute because its value can contain commas.
+func ParseAttr(attr string, vtype uint32) (*ParamAttr, error) {
+	ret := &ParamAttr{Source: attr}
+	var optional bool
+	for len(attr) > 0 {
+		var item string
+		if strings.HasPrefix(attr, `regex=`) {
+			item, attr = attr, ``
+		} else if off := strings.IndexByte(attr, ','); off >= 0 {
+			item, attr = attr[:off], attr[off+1:]
+		} else {
+			item, attr = attr, ``
+		}
+		name, value := strings.TrimSpace(item), ``
+		if off := strings.IndexByte(item, '='); off >= 0 {
+			name, value = strings.TrimSpace(item[:off]), item[off+1:]
+		}
+		switch name {
+		case `required`, `optional`:
+			if len(value) > 0 {
+				return nil, fmt.Errorf(errAttrValue, name)
+			}
+			ret.Required = ret.Required || name == `required`
+			optional = optional || name == `optional`
+		case `min`, `max`:
+			switch vtype {
+			case parser.VInt, parser.VFloat, parser.VMoney:
+			default:
+				return nil, fmt.Errorf(errAttrType, name, TypeName(int64(vtype)))
+			}
+			d, err := decimal.NewFromString(strings.TrimSpace(value))
+			if err != nil {
+				return nil, fmt.Errorf(errAttrValue, name)
+			}
+			if name == `min` {
+				ret.Min = &d
+			} else {
+				ret.Max = &d
+			}
+		case `maxlen`:
+			if vtype != parser.VStr && vtype != parser.VBytes {
+				return nil, fmt.Errorf(errAttrType, name, TypeName(int64(vtype)))
+			}
+			size, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || size <= 0 {
+				return nil, fmt.Errorf(errAttrValue, name)
+			}
+			ret.MaxLen = size
+		case `places`:
+			if vtype != parser.VMoney {
+				return nil, fmt.Errorf(errAttrType, name, TypeName(int64(vtype)))
+			}
+			places, err := strconv.Atoi(strings.TrimSpace(value))
+			if err != nil || places < 0 || places > MaxPlaces {
+				return nil, fmt.Errorf(errAttrValue, name)
+			}
+			ret.Places = places
+		case `regex`:
+			if vtype != parser.VStr {
+				return nil, fmt.Errorf(errAttrType, name, TypeName(int64(vtype)))
+			}
+			re, err := regexp.Compile(value)
+			if err != nil {
+				return nil, fmt.Errorf(errAttrValue, name)
+			}
+			ret.Regexp = re
+		default:
+			return nil, fmt.Errorf(errAttrUnknown, name)
+		}
+	}
+	if ret.Required && optional {
+		return nil, fmt.Errorf(errAttrConflict)
+	}
+	return ret, nil
+}
+
+// Check checks the value of the parameter which is passed by the host. The value must have
+// been checked for the type before.
+func (attr *ParamAttr) Check(name string, vtype uint32, v interface{}) error {
+	if v == nil {
+		if attr.Required {
+			return fmt.Errorf(errParamRequired, name)
+		}
+		return nil
+	}
+	length := -1
+	switch vVal := v.(type) {
+	case string:
+		switch vtype {
+		case parser.VInt, parser.VFloat, parser.VMoney:
+			d, err := decimal.NewFromString(vVal)
+			if err != nil {
+				return err
+			}
+			if attr.Min != nil && d.LessThan(*attr.Min) {
+				return fmt.Errorf(errParamMin, name, attr.Min.String())
+			}
+			if attr.Max != nil && d.GreaterThan(*attr.Max) {
+				return fmt.Errorf(errParamMax, name, attr.Max.String())
+			}
+		case parser.VStr:
+			length = utf8.RuneCountInString(vVal)
+			if attr.Regexp != nil && !attr.Regexp.MatchString(vVal) {
+				return fmt.Errorf(errParamRegexp, name, attr.Regexp.String())
+			}
+		case parser.VBytes:
+			length = hex.DecodedLen(len(vVal))
+		}
+	case []byte:
+		length = len(vVal)
+	}
+	if attr.MaxLen > 0 && length > attr.MaxLen {
+		return fmt.Errorf(errParamMaxLen, name, attr.MaxLen)
+	}
+	return nil
+}
+
+// checkPars checks the attributes of the parameters which are passed by the calling contract.
+// pars contains the pairs of the index of the parameter and the value. The money parameters
+// with places attribute are rounded down like the parameters of the host.
+func (rt *Runtime) checkPars(cnt *Contract, pars []int64) error {
+	if len(cnt.Attrs) == 0 {
+		return nil
+	}
+	for j := 0; j < len(pars); j += 2 {
+		for name, par := range cnt.Params {
+			attr := cnt.Attrs[name]
+			if int64(par.Index) != pars[j] || attr == nil {
+				continue
+			}
+			var v interface{}
+			val := pars[j+1]
+			switch par.Type {
+			case parser.VInt:
+				v = strconv.FormatInt(val, 10)
+			case parser.VFloat:
+				v = strconv.FormatFloat(*(*float64)(unsafe.Pointer(&val)), 'f', -1, 64)
+			case parser.VMoney:
+				v = rt.Objects[val].(decimal.Decimal).String()
+			case parser.VStr:
+				v = rt.Strings[val]
+			case parser.VBytes:
+				v = rt.Objects[val].([]byte)
+			}
+			if err := attr.Check(name, par.Type, v); err != nil {
+				return err
+			}
+			if par.Type == parser.VMoney && attr.Places > 0 {
+				rt.Objects[val] = ScaleMoney(rt.Objects[val].(decimal.Decimal), int32(attr.Places))
+			}
+		}
+	}
+	return nil
+}
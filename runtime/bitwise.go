@@ -0,0 +1,42 @@
+package runtime
+
+const (
+	errShiftCount = `negative shift count %d`
+	errBytesLen   = `bytes must have the same length %d != %d`
+)
+
+// shlInt returns a << n and false if the significant bits are lost
+func shlInt(a, n int64) (int64, bool) {
+	if n >= 64 {
+		return 0, a == 0
+	}
+	c := a << uint64(n)
+	return c, c>>uint64(n) == a
+}
+
+// shrInt returns the arithmetic shift a >> n
+func shrInt(a, n int64) int64 {
+	if n >= 64 {
+		n = 63
+	}
+	return a >> uint64(n)
+}
+
+// bitBytes applies the bitwise operation to the bytes item by item
+func bitBytes(cmd Bcode, a, b []byte) ([]byte, bool) {
+	if len(a) != len(b) {
+		return nil, false
+	}
+	ret := make([]byte, len(a))
+	for i := range a {
+		switch cmd {
+		case ANDBYTES, ASSIGNANDBYTES:
+			ret[i] = a[i] & b[i]
+		case ORBYTES, ASSIGNORBYTES:
+			ret[i] = a[i] | b[i]
+		case XORBYTES, ASSIGNXORBYTES:
+			ret[i] = a[i] ^ b[i]
+		}
+	}
+	return ret, true
+}
@@ -0,0 +1,410 @@
+package runtime
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"unsafe"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const errEmptyArr = `array is empty`
+
+// sizedFuncs contains the embedded functions which take the gas depending on the size of the data
+var sizedFuncs = make(map[uintptr]bool)
+
+func init() {
+	for _, f := range []interface{}{ValuesInt, ValuesBool, ValuesStr, ValuesFloat, ValuesMoney,
+		SortInt, SortStr, SortFloat, SortMoney, ReverseArr,
+		IndexOfInt, IndexOfBool, IndexOfStr, IndexOfFloat, IndexOfMoney,
+		ContainsInt, ContainsBool, ContainsStr, ContainsFloat, ContainsMoney, RemoveArr,
+		InsertInt, InsertBool, InsertStr, InsertFloat, InsertMoney,
+		UniqueInt, UniqueBool, UniqueStr, UniqueFloat, UniqueMoney,
+		SumInt, SumFloat, SumMoney, MinInt, MinFloat, MinMoney, MaxInt, MaxFloat, MaxMoney,
+		Repeat, PadLeft, PadRight,
+		RegexpMatch, RegexpFind, RegexpFindAll, RegexpReplace, RegexpSplit} {
+		sizedFuncs[reflect.ValueOf(f).Pointer()] = true
+	}
+}
+
+// Sized returns true if the gas of the function depends on the size of the data
+func (eFunc EmbedFunc) Sized() bool {
+	return sizedFuncs[reflect.ValueOf(eFunc.Func).Pointer()]
+}
+
+// spend adds the gas which depends on the count of the processed items
+func (rt *Runtime) spend(count int) {
+	rt.Cost += int64(count)
+}
+
+// less compares the items of int, str, float or money array
+func less(rt *Runtime, vtype int64, a, b int64) bool {
+	switch vtype & 0xf {
+	case parser.VStr:
+		return rt.Strings[a] < rt.Strings[b]
+	case parser.VFloat:
+		return *(*float64)(unsafe.Pointer(&a)) < *(*float64)(unsafe.Pointer(&b))
+	case parser.VMoney:
+		return rt.Objects[a].(decimal.Decimal).LessThan(rt.Objects[b].(decimal.Decimal))
+	}
+	return a < b
+}
+
+// itemKey returns the value which can be used as the key of Go map for the item
+func itemKey(rt *Runtime, vtype int64, v int64) interface{} {
+	switch vtype & 0xf {
+	case parser.VStr:
+		return rt.Strings[v]
+	case parser.VFloat:
+		return *(*float64)(unsafe.Pointer(&v))
+	case parser.VMoney:
+		return rt.Objects[v].(decimal.Decimal).String()
+	}
+	return v
+}
+
+// DeleteMap deletes the key from the map
+func DeleteMap(rt *Runtime, i, key int64) {
+	delete(rt.Objects[i].(map[string]int64), rt.Strings[key])
+}
+
+// HasKeyMap returns true if the map has the key
+func HasKeyMap(rt *Runtime, i, key int64) int64 {
+	if _, ok := rt.Objects[i].(map[string]int64)[rt.Strings[key]]; ok {
+		return 1
+	}
+	return 0
+}
+
+// values returns the array of map values sorted by the keys
+func values(rt *Runtime, vtype int64, i int64) int64 {
+	imap := rt.Objects[i].(map[string]int64)
+	keys := make([]string, 0, len(imap))
+	for key := range imap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	ret := make([]int64, len(keys))
+	for j, key := range keys {
+		ret[j] = copy(rt, vtype, imap[key])
+	}
+	rt.spend(len(keys))
+	rt.Objects = append(rt.Objects, ret)
+	return int64(len(rt.Objects) - 1)
+}
+
+// ValuesInt returns the values of map.int
+func ValuesInt(rt *Runtime, i int64) int64 {
+	return values(rt, parser.VInt, i)
+}
+
+// ValuesBool returns the values of map.bool
+func ValuesBool(rt *Runtime, i int64) int64 {
+	return values(rt, parser.VBool, i)
+}
+
+// ValuesStr returns the values of map.str
+func ValuesStr(rt *Runtime, i int64) int64 {
+	return values(rt, parser.VStr, i)
+}
+
+// ValuesFloat returns the values of map.float
+func ValuesFloat(rt *Runtime, i int64) int64 {
+	return values(rt, parser.VFloat, i)
+}
+
+// ValuesMoney returns the values of map.money
+func ValuesMoney(rt *Runtime, i int64) int64 {
+	return values(rt, parser.VMoney, i)
+}
+
+// sortArr sorts the items of the array in ascending order
+func sortArr(rt *Runtime, vtype int64, i int64) {
+	arr := rt.Objects[i].([]int64)
+	sort.SliceStable(arr, func(a, b int) bool {
+		return less(rt, vtype, arr[a], arr[b])
+	})
+	rt.spend(len(arr))
+}
+
+// SortInt sorts arr.int
+func SortInt(rt *Runtime, i int64) {
+	sortArr(rt, parser.VInt, i)
+}
+
+// SortStr sorts arr.str
+func SortStr(rt *Runtime, i int64) {
+	sortArr(rt, parser.VStr, i)
+}
+
+// SortFloat sorts arr.float
+func SortFloat(rt *Runtime, i int64) {
+	sortArr(rt, parser.VFloat, i)
+}
+
+// SortMoney sorts arr.money
+func SortMoney(rt *Runtime, i int64) {
+	sortArr(rt, parser.VMoney, i)
+}
+
+// ReverseArr reverses the order of the array items
+func ReverseArr(rt *Runtime, i int64) {
+	arr := rt.Objects[i].([]int64)
+	for left, right := 0, len(arr)-1; left < right; left, right = left+1, right-1 {
+		arr[left], arr[right] = arr[right], arr[left]
+	}
+	rt.spend(len(arr))
+}
+
+// indexOf returns the index of the first item which equals val or -1
+func indexOf(rt *Runtime, vtype int64, i, val int64) int64 {
+	arr := rt.Objects[i].([]int64)
+	for j, item := range arr {
+		if equal(rt, vtype, item, val) {
+			rt.spend(j + 1)
+			return int64(j)
+		}
+	}
+	rt.spend(len(arr))
+	return -1
+}
+
+// IndexOfInt returns the index of int in arr.int or -1
+func IndexOfInt(rt *Runtime, i, val int64) int64 {
+	return indexOf(rt, parser.VInt, i, val)
+}
+
+// IndexOfBool returns the index of bool in arr.bool or -1
+func IndexOfBool(rt *Runtime, i, val int64) int64 {
+	return indexOf(rt, parser.VBool, i, val)
+}
+
+// IndexOfStr returns the index of str in arr.str or -1
+func IndexOfStr(rt *Runtime, i, val int64) int64 {
+	return indexOf(rt, parser.VStr, i, val)
+}
+
+// IndexOfFloat returns the index of float in arr.float or -1
+func IndexOfFloat(rt *Runtime, i, val int64) int64 {
+	return indexOf(rt, parser.VFloat, i, val)
+}
+
+// IndexOfMoney returns the index of money in arr.money or -1
+func IndexOfMoney(rt *Runtime, i, val int64) int64 {
+	return indexOf(rt, parser.VMoney, i, val)
+}
+
+// contains returns 1 if the array has the item which equals val
+func contains(rt *Runtime, vtype int64, i, val int64) int64 {
+	if indexOf(rt, vtype, i, val) >= 0 {
+		return 1
+	}
+	return 0
+}
+
+// ContainsInt returns true if arr.int contains int
+func ContainsInt(rt *Runtime, i, val int64) int64 {
+	return contains(rt, parser.VInt, i, val)
+}
+
+// ContainsBool returns true if arr.bool contains bool
+func ContainsBool(rt *Runtime, i, val int64) int64 {
+	return contains(rt, parser.VBool, i, val)
+}
+
+// ContainsStr returns true if arr.str contains str
+func ContainsStr(rt *Runtime, i, val int64) int64 {
+	return contains(rt, parser.VStr, i, val)
+}
+
+// ContainsFloat returns true if arr.float contains float
+func ContainsFloat(rt *Runtime, i, val int64) int64 {
+	return contains(rt, parser.VFloat, i, val)
+}
+
+// ContainsMoney returns true if arr.money contains money
+func ContainsMoney(rt *Runtime, i, val int64) int64 {
+	return contains(rt, parser.VMoney, i, val)
+}
+
+// RemoveArr removes the item with the specified index, the negative index is counted from the end
+func RemoveArr(rt *Runtime, i, idx int64) error {
+	arr := rt.Objects[i].([]int64)
+	ind, ok := itemIndex(idx, len(arr))
+	if !ok {
+		return fmt.Errorf(errIndexOut, idx, len(arr))
+	}
+	rt.Objects[i] = append(arr[:ind], arr[ind+1:]...)
+	rt.spend(len(arr) - int(ind))
+	return nil
+}
+
+// insert inserts the copy of val before the item with the specified index. The index can
+// equal the length of the array, -1 appends val to the end.
+func insert(rt *Runtime, vtype int64, i, idx, val int64) error {
+	arr := rt.Objects[i].([]int64)
+	ind, ok := itemIndex(idx, len(arr)+1)
+	if !ok {
+		return fmt.Errorf(errIndexOut, idx, len(arr))
+	}
+	arr = append(arr, 0)
+	arr = append(arr[:ind+1], arr[ind:len(arr)-1]...)
+	arr[ind] = copy(rt, vtype, val)
+	rt.Objects[i] = arr
+	rt.spend(len(arr) - int(ind))
+	return nil
+}
+
+// InsertInt inserts int into arr.int
+func InsertInt(rt *Runtime, i, idx, val int64) error {
+	return insert(rt, parser.VInt, i, idx, val)
+}
+
+// InsertBool inserts bool into arr.bool
+func InsertBool(rt *Runtime, i, idx, val int64) error {
+	return insert(rt, parser.VBool, i, idx, val)
+}
+
+// InsertStr inserts str into arr.str
+func InsertStr(rt *Runtime, i, idx, val int64) error {
+	return insert(rt, parser.VStr, i, idx, val)
+}
+
+// InsertFloat inserts float into arr.float
+func InsertFloat(rt *Runtime, i, idx, val int64) error {
+	return insert(rt, parser.VFloat, i, idx, val)
+}
+
+// InsertMoney inserts money into arr.money
+func InsertMoney(rt *Runtime, i, idx, val int64) error {
+	return insert(rt, parser.VMoney, i, idx, val)
+}
+
+// unique removes the duplicates from the array, the first items are kept
+func unique(rt *Runtime, vtype int64, i int64) {
+	arr := rt.Objects[i].([]int64)
+	exist := make(map[interface{}]bool)
+	out := arr[:0]
+	for _, item := range arr {
+		key := itemKey(rt, vtype, item)
+		if !exist[key] {
+			exist[key] = true
+			out = append(out, item)
+		}
+	}
+	rt.Objects[i] = out
+	rt.spend(len(arr))
+}
+
+// UniqueInt removes the duplicates from arr.int
+func UniqueInt(rt *Runtime, i int64) {
+	unique(rt, parser.VInt, i)
+}
+
+// UniqueBool removes the duplicates from arr.bool
+func UniqueBool(rt *Runtime, i int64) {
+	unique(rt, parser.VBool, i)
+}
+
+// UniqueStr removes the duplicates from arr.str
+func UniqueStr(rt *Runtime, i int64) {
+	unique(rt, parser.VStr, i)
+}
+
+// UniqueFloat removes the duplicates from arr.float
+func UniqueFloat(rt *Runtime, i int64) {
+	unique(rt, parser.VFloat, i)
+}
+
+// UniqueMoney removes the duplicates from arr.money
+func UniqueMoney(rt *Runtime, i int64) {
+	unique(rt, parser.VMoney, i)
+}
+
+// SumInt returns the sum of arr.int
+func SumInt(rt *Runtime, i int64) (int64, error) {
+	var (
+		sum int64
+		ok  bool
+	)
+	arr := rt.Objects[i].([]int64)
+	rt.spend(len(arr))
+	for _, item := range arr {
+		if sum, ok = addInt(sum, item); !ok && rt.Checked {
+			return 0, &OverflowError{}
+		}
+	}
+	return sum, nil
+}
+
+// SumFloat returns the sum of arr.float
+func SumFloat(rt *Runtime, i int64) int64 {
+	var sum float64
+	arr := rt.Objects[i].([]int64)
+	rt.spend(len(arr))
+	for _, item := range arr {
+		sum += *(*float64)(unsafe.Pointer(&item))
+	}
+	return *(*int64)(unsafe.Pointer(&sum))
+}
+
+// SumMoney returns the sum of arr.money
+func SumMoney(rt *Runtime, i int64) int64 {
+	sum := decimal.Zero
+	arr := rt.Objects[i].([]int64)
+	rt.spend(len(arr))
+	for _, item := range arr {
+		sum = sum.Add(rt.Objects[item].(decimal.Decimal))
+	}
+	rt.Objects = append(rt.Objects, sum)
+	return int64(len(rt.Objects) - 1)
+}
+
+// extremum returns the minimum or the maximum item of the array
+func extremum(rt *Runtime, vtype int64, i int64, max bool) (int64, error) {
+	arr := rt.Objects[i].([]int64)
+	if len(arr) == 0 {
+		return 0, fmt.Errorf(errEmptyArr)
+	}
+	rt.spend(len(arr))
+	ret := arr[0]
+	for _, item := range arr[1:] {
+		if less(rt, vtype, item, ret) != max && !equal(rt, vtype, item, ret) {
+			ret = item
+		}
+	}
+	return copy(rt, vtype, ret), nil
+}
+
+// MinInt returns the minimum of arr.int
+func MinInt(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VInt, i, false)
+}
+
+// MinFloat returns the minimum of arr.float
+func MinFloat(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VFloat, i, false)
+}
+
+// MinMoney returns the minimum of arr.money
+func MinMoney(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VMoney, i, false)
+}
+
+// MaxInt returns the maximum of arr.int
+func MaxInt(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VInt, i, true)
+}
+
+// MaxFloat returns the maximum of arr.float
+func MaxFloat(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VFloat, i, true)
+}
+
+// MaxMoney returns the maximum of arr.money
+func MaxMoney(rt *Runtime, i int64) (int64, error) {
+	return extremum(rt, parser.VMoney, i, true)
+}
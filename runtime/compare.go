@@ -0,0 +1,57 @@
+package runtime
+
+import (
+	"bytes"
+	"unsafe"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/types"
+)
+
+// equal compares the values of the specified type. The arrays and the maps are equal if
+// they have the equal items.
+func equal(rt *Runtime, vtype int64, a, b int64) bool {
+	switch vtype & 0xf {
+	case parser.VStr:
+		return rt.Strings[a] == rt.Strings[b]
+	case parser.VFloat:
+		return *(*float64)(unsafe.Pointer(&a)) == *(*float64)(unsafe.Pointer(&b))
+	case parser.VMoney:
+		return rt.Objects[a].(decimal.Decimal).Equal(rt.Objects[b].(decimal.Decimal))
+	case parser.VBytes:
+		return bytes.Equal(rt.Objects[a].([]byte), rt.Objects[b].([]byte))
+	case parser.VObject:
+		return types.Equal(rt.Objects[a], rt.Objects[b])
+	case parser.VArr:
+		arrA, arrB := rt.Objects[a].([]int64), rt.Objects[b].([]int64)
+		if len(arrA) != len(arrB) {
+			return false
+		}
+		for i := range arrA {
+			if !equal(rt, vtype>>4, arrA[i], arrB[i]) {
+				return false
+			}
+		}
+		return true
+	case parser.VMap:
+		mapA, mapB := rt.Objects[a].(map[string]int64), rt.Objects[b].(map[string]int64)
+		if len(mapA) != len(mapB) {
+			return false
+		}
+		for key, val := range mapA {
+			valB, ok := mapB[key]
+			if !ok || !equal(rt, vtype>>4, val, valB) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
+
+// compareBytes compares two bytes values, the result is -1, 0 or 1
+func compareBytes(rt *Runtime, a, b int64) int {
+	return bytes.Compare(rt.Objects[a].([]byte), rt.Objects[b].([]byte))
+}
@@ -0,0 +1,44 @@
+package runtime
+
+// The origins of the errors which can be caught by try
+const (
+	OriginError    = `error`    // error(code, message) has been called
+	OriginStdlib   = `stdlib`   // the built-in function or the virtual machine
+	OriginCustom   = `custom`   // the custom function of the host
+	OriginContract = `contract` // the called contract
+)
+
+// Error is the error which is raised by error(code, message). The other errors are converted
+// to Error when they are caught by try.
+type Error struct {
+	Code    string
+	Message string
+	Origin  string
+}
+
+func (e *Error) Error() string {
+	if len(e.Code) == 0 {
+		return e.Message
+	}
+	return e.Code + `: ` + e.Message
+}
+
+// newError returns the error which is assigned to the variable of catch
+func newError(err error, origin string) *Error {
+	ret := &Error{Message: err.Error(), Origin: origin}
+	if e, ok := err.(*Error); ok {
+		ret.Code, ret.Message = e.Code, e.Message
+	}
+	if len(ret.Origin) == 0 {
+		ret.Origin = OriginStdlib
+	}
+	return ret
+}
+
+// tryBlock is the handler of the errors which is created by TRY
+type tryBlock struct {
+	Catch int64 // the offset of the catch block
+	Var   Bcode // the index of the variable of the error
+	Top   int64
+	Coff  int64
+}
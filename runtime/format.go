@@ -0,0 +1,96 @@
+package runtime
+
+import (
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const (
+	errFormatVerb  = `Sprintf verb %%%c doesn't support %s type`
+	errFormatCount = `Sprintf format has %d verbs but %d values`
+	errFormatEnd   = `Sprintf format ends with incomplete verb`
+)
+
+// typeVerbs contains the verbs of the types besides %v, other types support only %s and %v
+var typeVerbs = map[int64]string{
+	parser.VInt:   `bcdoOqxXU`,
+	parser.VFloat: `eEfFgGxX`,
+	parser.VStr:   `sqxX`,
+	parser.VBool:  `t`,
+	parser.VBytes: `sqxX`,
+}
+
+// formatVerbs returns the verbs of Sprintf format, the flags, the width and the precision
+// are skipped
+func formatVerbs(format string) ([]rune, error) {
+	var verbs []rune
+	runes := []rune(format)
+	for i := 0; i < len(runes); i++ {
+		if runes[i] != '%' {
+			continue
+		}
+		i++
+		for i < len(runes) && strings.ContainsRune(`+-# 0123456789.`, runes[i]) {
+			i++
+		}
+		if i == len(runes) {
+			return nil, fmt.Errorf(errFormatEnd)
+		}
+		if runes[i] != '%' {
+			verbs = append(verbs, runes[i])
+		}
+	}
+	return verbs, nil
+}
+
+// CheckFormat checks that Sprintf format has the verbs for the values of the specified types
+func CheckFormat(format string, vtypes []int64) error {
+	verbs, err := formatVerbs(format)
+	if err != nil {
+		return err
+	}
+	if len(verbs) != len(vtypes) {
+		return fmt.Errorf(errFormatCount, len(verbs), len(vtypes))
+	}
+	for i, verb := range verbs {
+		allowed, ok := typeVerbs[vtypes[i]]
+		if !ok {
+			allowed = `s`
+		}
+		if verb != 'v' && !strings.ContainsRune(allowed, verb) {
+			return fmt.Errorf(errFormatVerb, verb, TypeName(vtypes[i]))
+		}
+	}
+	return nil
+}
+
+// sprintf formats the values according to the format. The values of the composite types
+// are printed like the result of the contract.
+func (rt *Runtime) sprintf(format string, values []int64, vtypes []int64) (string, error) {
+	if err := CheckFormat(format, vtypes); err != nil {
+		return ``, err
+	}
+	args := make([]interface{}, len(values))
+	for i, val := range values {
+		switch vtypes[i] {
+		case parser.VInt:
+			args[i] = val
+		case parser.VFloat:
+			args[i] = *(*float64)(unsafe.Pointer(&val))
+		case parser.VStr:
+			args[i] = rt.Strings[val]
+		case parser.VBool:
+			args[i] = val != 0
+		case parser.VBytes:
+			args[i] = rt.Objects[val].([]byte)
+		case parser.VFile:
+			args[i] = rt.Objects[val]
+		default:
+			args[i] = print(rt, val, vtypes[i])
+		}
+	}
+	return fmt.Sprintf(format, args...), nil
+}
@@ -0,0 +1,162 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"unsafe"
+
+	"github.com/shopspring/decimal"
+)
+
+const (
+	errMoneyPlaces = `Number of decimal places must be from 0 to %d`
+	errMoneyPow    = `Exponent of money must be from 0 to %d`
+	errMoneyInt    = `Money %s is out of the range of int`
+
+	// MaxPlaces is the maximum number of decimal places of money
+	MaxPlaces = 30
+	// maxMoneyPow is the maximum exponent of Pow(money, int)
+	maxMoneyPow = 64
+)
+
+// ScaleMoney returns the value rounded down to the specified number of decimal places.
+// The constructors of money use it, so the value without places is rounded down to integer.
+func ScaleMoney(d decimal.Decimal, places int32) decimal.Decimal {
+	if places == 0 {
+		return d.Floor()
+	}
+	return d.Shift(places).Floor().Shift(-places)
+}
+
+// moneyPlaces checks the number of decimal places
+func moneyPlaces(places int64) (int32, error) {
+	if places < 0 || places > MaxPlaces {
+		return 0, fmt.Errorf(errMoneyPlaces, MaxPlaces)
+	}
+	return int32(places), nil
+}
+
+// newMoney appends the money value to the objects
+func (rt *Runtime) newMoney(d decimal.Decimal) int64 {
+	rt.Objects = append(rt.Objects, d)
+	return int64(len(rt.Objects) - 1)
+}
+
+// MoneyFloatPlaces converts a float number to money with the specified decimal places
+func MoneyFloatPlaces(rt *Runtime, i, places int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	return rt.newMoney(ScaleMoney(decimal.NewFromFloat(*(*float64)(unsafe.Pointer(&i))), scale)), nil
+}
+
+// MoneyStrPlaces converts a string to money with the specified decimal places
+func MoneyStrPlaces(rt *Runtime, i, places int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	d, err := decimal.NewFromString(rt.Strings[i])
+	if err != nil {
+		return 0, err
+	}
+	return rt.newMoney(ScaleMoney(d, scale)), nil
+}
+
+// RoundMoney rounds the money to the decimal places, half is rounded away from zero
+func RoundMoney(rt *Runtime, i, places int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Round(scale)), nil
+}
+
+// RoundBank rounds the money to the decimal places, half is rounded to even
+func RoundBank(rt *Runtime, i, places int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).RoundBank(scale)), nil
+}
+
+// Truncate drops the digits of the money after the decimal places
+func Truncate(rt *Runtime, i, places int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Truncate(scale)), nil
+}
+
+// CeilMoney returns the least integer value greater than or equal to the money
+func CeilMoney(rt *Runtime, i int64) int64 {
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Ceil())
+}
+
+// AbsMoney returns the absolute value of the money
+func AbsMoney(rt *Runtime, i int64) int64 {
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Abs())
+}
+
+// PowMoney returns the money to the power of the integer exponent
+func PowMoney(rt *Runtime, i, exp int64) (int64, error) {
+	if exp < 0 || exp > maxMoneyPow {
+		return 0, fmt.Errorf(errMoneyPow, maxMoneyPow)
+	}
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Pow(decimal.New(exp, 0))), nil
+}
+
+// Percent returns the part of the money in basis points, 1 bp is 0.01%
+func Percent(rt *Runtime, i, bp int64) int64 {
+	return rt.newMoney(rt.Objects[i].(decimal.Decimal).Mul(decimal.New(bp, -4)))
+}
+
+// CmpMoney returns -1, 0 or 1 if the first money is less, equal or greater than the second one
+func CmpMoney(rt *Runtime, left, right int64) int64 {
+	return int64(rt.Objects[left].(decimal.Decimal).Cmp(rt.Objects[right].(decimal.Decimal)))
+}
+
+// FormatMoney formats the money with the fixed decimal places, sep separates the thousands
+// of the integer part
+func FormatMoney(rt *Runtime, i, places, sep int64) (int64, error) {
+	scale, err := moneyPlaces(places)
+	if err != nil {
+		return 0, err
+	}
+	out := rt.Objects[i].(decimal.Decimal).StringFixed(scale)
+	var sign string
+	if strings.HasPrefix(out, `-`) {
+		sign, out = `-`, out[1:]
+	}
+	intPart, fraction := out, ``
+	if off := strings.IndexByte(out, '.'); off >= 0 {
+		intPart, fraction = out[:off], out[off:]
+	}
+	var groups []string
+	for len(intPart) > 3 {
+		groups = append([]string{intPart[len(intPart)-3:]}, groups...)
+		intPart = intPart[:len(intPart)-3]
+	}
+	groups = append([]string{intPart}, groups...)
+	rt.Strings = append(rt.Strings, sign+strings.Join(groups, rt.Strings[sep])+fraction)
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// IntMoney converts the money to the integer number, the fractional part is dropped
+func IntMoney(rt *Runtime, i int64) (int64, error) {
+	d := rt.Objects[i].(decimal.Decimal).Truncate(0)
+	if d.LessThan(decimal.New(math.MinInt64, 0)) || d.GreaterThan(decimal.New(math.MaxInt64, 0)) {
+		return 0, fmt.Errorf(errMoneyInt, d.String())
+	}
+	return d.IntPart(), nil
+}
+
+// FloatMoney converts the money to the float number
+func FloatMoney(rt *Runtime, i int64) int64 {
+	f, _ := rt.Objects[i].(decimal.Decimal).Float64()
+	return *(*int64)(unsafe.Pointer(&f))
+}
@@ -0,0 +1,88 @@
+package runtime
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+const errIntOverflow = `integer overflow`
+
+// OverflowError is returned when the result of the integer operation doesn't fit in int64.
+// Line and Column are the position of the operation in the source of the contract.
+type OverflowError struct {
+	Contract string
+	Line     int
+	Column   uint32
+}
+
+func (e *OverflowError) Error() string {
+	if e.Line == 0 {
+		return errIntOverflow
+	}
+	return fmt.Sprintf(`%s %d:%d: %s`, e.Contract, e.Line, e.Column, errIntOverflow)
+}
+
+// overflow returns the error of the integer operation at the specified offset of the code
+func (cnt *Contract) overflow(offset int64) error {
+	err := &OverflowError{Contract: cnt.Name}
+	ind := sort.Search(len(cnt.Positions), func(i int) bool {
+		return int64(cnt.Positions[i].Offset) >= offset
+	})
+	if ind < len(cnt.Positions) && int64(cnt.Positions[ind].Offset) == offset {
+		err.Line, err.Column = cnt.Positions[ind].Line, cnt.Positions[ind].Column
+	}
+	return err
+}
+
+// addInt returns a + b and false if the sum overflows
+func addInt(a, b int64) (int64, bool) {
+	c := a + b
+	return c, (c >= a) == (b >= 0)
+}
+
+// subInt returns a - b and false if the difference overflows
+func subInt(a, b int64) (int64, bool) {
+	c := a - b
+	return c, (c <= a) == (b >= 0)
+}
+
+// mulInt returns a * b and false if the product overflows
+func mulInt(a, b int64) (int64, bool) {
+	if a == 0 || b == 0 {
+		return 0, true
+	}
+	c := a * b
+	return c, c/b == a && !(a == math.MinInt64 && b == -1)
+}
+
+// divInt returns a / b and false if the quotient overflows, b must not be zero
+func divInt(a, b int64) (int64, bool) {
+	return a / b, a != math.MinInt64 || b != -1
+}
+
+// negInt returns -a and false if a is the minimum value
+func negInt(a int64) (int64, bool) {
+	return -a, a != math.MinInt64
+}
+
+// FoldInt calculates the integer command ADDINT, SUBINT, MULINT, DIVINT, SHLINT or SIGNINT
+// with constant operands like the checked mode does. It returns false if the result overflows,
+// b must not be zero for DIVINT and must not be negative for SHLINT.
+func FoldInt(cmd Bcode, a, b int64) (int64, bool) {
+	switch cmd {
+	case ADDINT:
+		return addInt(a, b)
+	case SUBINT:
+		return subInt(a, b)
+	case MULINT:
+		return mulInt(a, b)
+	case DIVINT:
+		return divInt(a, b)
+	case SHLINT:
+		return shlInt(a, b)
+	case SIGNINT:
+		return negInt(a)
+	}
+	return 0, false
+}
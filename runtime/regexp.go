@@ -0,0 +1,113 @@
+package runtime
+
+import (
+	"fmt"
+	"regexp"
+	"regexp/syntax"
+)
+
+const errRegexp = `Invalid regular expression: %s`
+
+// Pattern is the compiled regular expression, Cost is the count of the instructions
+// of the program which is used as the complexity of the pattern
+type Pattern struct {
+	Regexp *regexp.Regexp
+	Cost   int
+}
+
+// CompilePattern compiles the regular expression of RE2 syntax
+func CompilePattern(expr string) (*Pattern, error) {
+	re, err := regexp.Compile(expr)
+	if err != nil {
+		return nil, fmt.Errorf(errRegexp, err)
+	}
+	parsed, err := syntax.Parse(expr, syntax.Perl)
+	if err != nil {
+		return nil, fmt.Errorf(errRegexp, err)
+	}
+	prog, err := syntax.Compile(parsed.Simplify())
+	if err != nil {
+		return nil, fmt.Errorf(errRegexp, err)
+	}
+	return &Pattern{Regexp: re, Cost: len(prog.Inst)}, nil
+}
+
+// pattern returns the compiled regular expression, the patterns are cached during the run.
+// The gas depends on the complexity of the pattern and the length of the input.
+func (rt *Runtime) pattern(expr, input int64) (*regexp.Regexp, error) {
+	if rt.Patterns == nil {
+		rt.Patterns = make(map[string]*Pattern)
+	}
+	ptn, ok := rt.Patterns[rt.Strings[expr]]
+	if !ok {
+		var err error
+		if ptn, err = CompilePattern(rt.Strings[expr]); err != nil {
+			return nil, err
+		}
+		rt.Patterns[rt.Strings[expr]] = ptn
+	}
+	rt.spend(ptn.Cost + len(rt.Strings[input]))
+	return ptn.Regexp, nil
+}
+
+// strArray returns the array of the strings
+func (rt *Runtime) strArray(list []string) int64 {
+	ret := make([]int64, len(list))
+	for i, val := range list {
+		rt.Strings = append(rt.Strings, val)
+		ret[i] = int64(len(rt.Strings) - 1)
+	}
+	rt.Objects = append(rt.Objects, ret)
+	return int64(len(rt.Objects) - 1)
+}
+
+// RegexpMatch returns true if s contains any match of the pattern
+func RegexpMatch(rt *Runtime, expr, s int64) (int64, error) {
+	re, err := rt.pattern(expr, s)
+	if err != nil {
+		return 0, err
+	}
+	if re.MatchString(rt.Strings[s]) {
+		return 1, nil
+	}
+	return 0, nil
+}
+
+// RegexpFind returns the leftmost match of the pattern in s or the empty string
+func RegexpFind(rt *Runtime, expr, s int64) (int64, error) {
+	re, err := rt.pattern(expr, s)
+	if err != nil {
+		return 0, err
+	}
+	rt.Strings = append(rt.Strings, re.FindString(rt.Strings[s]))
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// RegexpFindAll returns all matches of the pattern in s
+func RegexpFindAll(rt *Runtime, expr, s int64) (int64, error) {
+	re, err := rt.pattern(expr, s)
+	if err != nil {
+		return 0, err
+	}
+	return rt.strArray(re.FindAllString(rt.Strings[s], -1)), nil
+}
+
+// RegexpReplace replaces the matches of the pattern in s with repl, $1 in repl is
+// the text of the first group
+func RegexpReplace(rt *Runtime, expr, s, repl int64) (int64, error) {
+	re, err := rt.pattern(expr, s)
+	if err != nil {
+		return 0, err
+	}
+	rt.Strings = append(rt.Strings, re.ReplaceAllString(rt.Strings[s], rt.Strings[repl]))
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// RegexpSplit splits s into the substrings between the matches of the pattern
+func RegexpSplit(rt *Runtime, expr, s int64) (int64, error) {
+	re, err := rt.pattern(expr, s)
+	if err != nil {
+		return 0, err
+	}
+	return rt.strArray(re.Split(rt.Strings[s], -1)), nil
+}
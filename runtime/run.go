@@ -33,14 +33,25 @@ type objCount struct {
 	Objects int
 }
 
+const (
+	Debug = false
+)
+
 func DebugPrintf(formatString string, a ...interface{}) {
-	Debug := true
 	if Debug {
 		fmt.Printf("vm execute: ")
 		fmt.Printf(formatString, a...)
 	}
 }
 
+// newVars allocates the variables on the heap. SETVAR pushes the addresses of the variables,
+// so they must not be on the goroutine stack which is moved when it grows.
+//
+//go:noinline
+func newVars() []int64 {
+	return make([]int64, 0, 1024)
+}
+
 // Run executes a bytecode
 func (rt *Runtime) Run(contract *Contract, code []Bcode, params []int64, gasLimit int64) (string, int64, error) {
 	var (
@@ -49,6 +60,9 @@ func (rt *Runtime) Run(contract *Contract, code []Bcode, params []int64, gasLimi
 		data              []byte
 		counts            []objCount
 		isParContract     bool
+		rerr              error // the error which can be caught by try
+		origin            string
+		tries             []tryBlock
 	)
 	length := int64(len(code))
 	if length == 0 {
@@ -72,7 +86,7 @@ func (rt *Runtime) Run(contract *Contract, code []Bcode, params []int64, gasLimi
 	}
 	newCount()
 	defer delCount(true)
-	Vars := make([]int64, 0, 1024)
+	Vars := newVars()
 	stack := make([]int64, 100) // 运行栈
 	pars := make([]int64, 0, 32)
 	calls := make([]int64, 1000)
@@ -91,7 +105,7 @@ func (rt *Runtime) Run(contract *Contract, code []Bcode, params []int64, gasLimi
 			rt.Strings = append(rt.Strings, ``)
 			v = int64(len(rt.Strings) - 1)
 			Vars = append(Vars, v)
-		case parser.VInt:
+		case parser.VInt, parser.VBool, parser.VFloat:
 			Vars = append(Vars, 0)
 		default:
 			rt.Strings = append(rt.Strings, ``)
@@ -126,6 +140,8 @@ func (rt *Runtime) Run(contract *Contract, code []Bcode, params []int64, gasLimi
 			rt.Objects = append(rt.Objects, types.NewMap())
 			v = int64(len(rt.Objects) - 1)
 			Vars = append(Vars, v)
+		case parser.VStruct:
+			Vars = append(Vars, rt.newValue(contract, int64(value.Type)))
 		}
 	}
 
@@ -264,31 +280,53 @@ main:
 
 		case ADDINT:
 			top--
-			stack[top] += stack[top+1]
+			v, ok := addInt(stack[top], stack[top+1])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			stack[top] = v
 			DebugPrintf("ADDINT\n")
 
 		case SUBINT:
 			top--
-			stack[top] -= stack[top+1]
+			v, ok := subInt(stack[top], stack[top+1])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			stack[top] = v
 			DebugPrintf("SUBINT\n")
 
 		case MULINT:
 			top--
-			stack[top] *= stack[top+1]
+			v, ok := mulInt(stack[top], stack[top+1])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			stack[top] = v
 			DebugPrintf("MULINT\n")
 
 		case DIVINT:
 			top--
 			if stack[top+1] == 0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
+			}
+			v, ok := divInt(stack[top], stack[top+1])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
 			}
-			stack[top] /= stack[top+1]
+			stack[top] = v
 			DebugPrintf("DIVINT\n")
 
 		case MODINT:
 			top--
 			if stack[top+1] == 0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			stack[top] %= stack[top+1]
 			DebugPrintf("MODINT\n")
@@ -412,31 +450,57 @@ main:
 			top -= 2
 
 		case ASSIGNADDINT:
-			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) += stack[top]
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			v, ok := addInt(*ptr, stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			*ptr = v
 			top -= 2
 			DebugPrintf("ASSIGNADDINT\n")
 
 		case ASSIGNSUBINT:
-			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) -= stack[top]
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			v, ok := subInt(*ptr, stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			*ptr = v
 			top -= 2
 			DebugPrintf("ASSIGNSUBINT\n")
 
 		case ASSIGNMULINT:
-			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) *= stack[top]
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			v, ok := mulInt(*ptr, stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			*ptr = v
 			top -= 2
 			DebugPrintf("ASSIGNMULINT\n")
 
 		case ASSIGNDIVINT:
 			if stack[top] == 0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
+			}
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			v, ok := divInt(*ptr, stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
 			}
-			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) /= stack[top]
+			*ptr = v
 			top -= 2
 			DebugPrintf("ASSIGNDIVINT\n")
 
 		case ASSIGNMODINT:
 			if stack[top] == 0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) %= stack[top]
 			top -= 2
@@ -479,11 +543,12 @@ main:
 			}
 			var result []reflect.Value
 			result = reflect.ValueOf(eFunc.Func).Call(parsFunc) // 调用自定义函数
-			gas -= result[len(result)-2].Interface().(int64)    // 从预定义的gas数量中减去消耗的
+			gas += result[len(result)-2].Interface().(int64)    // 加上自定义函数消耗的gas
 			last := result[len(result)-1].Interface()           // 检查函数执行是否返回错误
 			if last != nil {
 				if _, isError := last.(error); isError {
-					return ``, gas, result[len(result)-1].Interface().(error)
+					rerr, origin = result[len(result)-1].Interface().(error), OriginCustom
+					break main
 				}
 			}
 			if eFunc.Result != parser.VVoid {
@@ -503,7 +568,8 @@ main:
 					rt.Strings = append(rt.Strings, result[0].Interface().(string))
 					stack[top] = int64(len(rt.Strings) - 1)
 				default:
-					return ``, gas, fmt.Errorf(errRetType, eFunc.Name)
+					rerr, origin = fmt.Errorf(errRetType, eFunc.Name), OriginCustom
+					break main
 				}
 			}
 
@@ -520,32 +586,51 @@ main:
 			}
 			var result []reflect.Value
 			result = reflect.ValueOf(eFunc.Func).Call(parsFunc)
-			gas -= eFunc.Gas
+			gas += eFunc.Gas + rt.Cost // 集合函数的gas取决于集合的大小
+			rt.Cost = 0
 			if len(result) > 0 {
 				last := result[len(result)-1].Interface()
 				if last != nil {
 					if _, isError := last.(error); isError {
-						return ``, gas, result[len(result)-1].Interface().(error)
+						rerr = result[len(result)-1].Interface().(error)
+						break main
 					}
 				}
-				top++
-				stack[top] = result[0].Interface().(int64)
+				if eFunc.Result != parser.VVoid {
+					top++
+					stack[top] = result[0].Interface().(int64)
+				}
 			}
 			DebugPrintf("EMBEDFUNC    name: %s    args_count:%d\n", eFunc.Name, eFunc.Params+1)
 
 		case CALLCONTRACT: // 调用其他contract
 			i++
 			top++
-			result, cgas, cerr := rt.Run((*rt.Contracts)[code[i]], (*rt.Contracts)[code[i]].Code, pars, gasLimit-gas)
+			// the called contracts are executed entirely
+			validate := rt.Validate
+			rt.Validate = false
+			var (
+				result string
+				cgas   int64
+			)
+			cerr := rt.checkPars((*rt.Contracts)[code[i]], pars)
+			if cerr == nil {
+				result, cgas, cerr = rt.Run((*rt.Contracts)[code[i]], (*rt.Contracts)[code[i]].Code, pars, gasLimit-gas)
+			}
+			rt.Validate = validate
 			if isParContract {
 				delCount(false)
 				isParContract = false
 			}
 
 			pars = pars[:0]
-			gas -= cgas
+			gas += cgas
 			if cerr != nil {
-				return ``, gas, cerr
+				if cerr.Error() == errGasLimit {
+					return ``, gas, cerr
+				}
+				rerr, origin = cerr, OriginContract
+				break main
 			}
 			rt.Strings = append(rt.Strings, result)
 			stack[top] = int64(len(rt.Strings) - 1)
@@ -612,10 +697,19 @@ main:
 			//Vars = Vars[:b] // 恢复Vars数组
 			coff -= 2
 			i = calls[coff] // 恢复指令指针
+			// the try blocks of the function are removed
+			for len(tries) > 0 && tries[len(tries)-1].Coff > coff {
+				tries = tries[:len(tries)-1]
+			}
 			continue
 
 		case SIGNINT:
-			stack[top] = -stack[top]
+			v, ok := negInt(stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			stack[top] = v
 			DebugPrintf("SIGNINT\n")
 
 		case NOT:
@@ -659,49 +753,111 @@ main:
 		case GETINDEX:
 			switch v := rt.Objects[stack[top-1]].(type) {
 			case []int64:
-				if stack[top] >= int64(len(v)) || stack[top] < 0 {
-					return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(v))
+				idx, ok := itemIndex(stack[top], len(v))
+				if !ok {
+					rerr = fmt.Errorf(errIndexOut, stack[top], len(v))
+					break main
 				}
-				stack[top-1] = v[stack[top]]
+				stack[top-1] = v[idx]
 			case []uint8:
-				if stack[top] >= int64(len(v)) || stack[top] < 0 {
-					return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(v))
+				idx, ok := itemIndex(stack[top], len(v))
+				if !ok {
+					rerr = fmt.Errorf(errIndexOut, stack[top], len(v))
+					break main
 				}
-				stack[top-1] = int64(v[stack[top]])
+				stack[top-1] = int64(v[idx])
 			}
 			top--
 			DebugPrintf("GETINDEX\n")
 
 		case SETINDEX:
-			idx := stack[top-1]
-			DebugPrintf("SETINDEX    index:%d    Objects length: %d\n", idx, len(rt.Objects))
+			DebugPrintf("SETINDEX    index:%d    Objects length: %d\n", stack[top-1], len(rt.Objects))
+			var length int
 			switch v := rt.Objects[stack[top-1]].(type) {
 			case []int64:
-				if stack[top] >= int64(len(v)) || stack[top] < 0 {
-					return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(v))
-				}
+				length = len(v)
 			case []uint8:
-				if stack[top] >= int64(len(v)) || stack[top] < 0 {
-					return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(v))
-				}
+				length = len(v)
+			}
+			idx, ok := itemIndex(stack[top], length)
+			if !ok {
+				rerr = fmt.Errorf(errIndexOut, stack[top], length)
+				break main
 			}
+			stack[top] = idx
 
 		case GETMAP:
 			imap := rt.Objects[stack[top-1]].(map[string]int64)
 			if stack[top] >= int64(len(rt.Strings)) || stack[top] < 0 {
-				return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(rt.Strings))
+				rerr = fmt.Errorf(errIndexOut, stack[top], len(rt.Strings))
+				break main
 			}
 			if val, ok := imap[rt.Strings[stack[top]]]; ok {
 				stack[top-1] = val
 			} else {
-				return ``, gas, fmt.Errorf(errIndexMap, rt.Strings[stack[top]])
+				rerr = fmt.Errorf(errIndexMap, rt.Strings[stack[top]])
+				break main
 			}
 			top--
 			DebugPrintf("GETMAP\n")
 
+		case GETMAPDEF:
+			i++
+			imap := rt.Objects[stack[top-1]].(map[string]int64)
+			if val, ok := imap[rt.Strings[stack[top]]]; ok {
+				stack[top-1] = val
+			} else {
+				stack[top-1] = rt.newValue(contract, int64(code[i]))
+			}
+			top--
+			DebugPrintf("GETMAPDEF    type: %d\n", code[i])
+
+		case SLICE:
+			var end int64
+			hasEnd := code[i+2] != 0
+			if hasEnd {
+				end = stack[top]
+				top--
+			}
+			top--
+			ret, length, err := rt.slice(int64(code[i+1]), stack[top], stack[top+1], end, hasEnd)
+			if err != nil {
+				rerr = err
+				break main
+			}
+			gas += length
+			stack[top] = ret
+			DebugPrintf("SLICE    type: %d    end: %v\n", code[i+1], hasEnd)
+			i += 2
+
+		case SPRINTF:
+			count := int64(code[i+1])
+			vtypes := make([]int64, count)
+			for k := range vtypes {
+				vtypes[k] = int64(code[i+2+int64(k)])
+			}
+			top -= count
+			out, err := rt.sprintf(rt.Strings[stack[top]], stack[top+1:top+1+count], vtypes)
+			if err != nil {
+				rerr = err
+				break main
+			}
+			rt.Strings = append(rt.Strings, out)
+			stack[top] = int64(len(rt.Strings) - 1)
+			gas += count
+			DebugPrintf("SPRINTF    count: %d\n", count)
+			i += count + 1
+
+		case DUP2:
+			stack[top+1] = stack[top-1]
+			stack[top+2] = stack[top]
+			top += 2
+			DebugPrintf("DUP2\n")
+
 		case SETMAP:
 			if stack[top] >= int64(len(rt.Strings)) || stack[top] < 0 {
-				return ``, gas, fmt.Errorf(errIndexOut, stack[top], len(rt.Strings))
+				rerr = fmt.Errorf(errIndexOut, stack[top], len(rt.Strings))
+				break main
 			}
 			DebugPrintf("SETMAP\n")
 
@@ -729,7 +885,8 @@ main:
 		case ASSIGNSETBYTES:
 			ibyte := rt.Objects[stack[top-2]].([]uint8)
 			if uint64(stack[top]) > 255 {
-				return ``, gas, fmt.Errorf(errBytesVal)
+				rerr = fmt.Errorf(errBytesVal)
+				break main
 			}
 			ibyte[stack[top-1]] = uint8(stack[top])
 			top -= 3
@@ -819,7 +976,8 @@ main:
 			i++
 			envVal := rt.Env[int64(code[i])]
 			if !envVal.Init {
-				return ``, gas, fmt.Errorf(errGlobVar)
+				rerr = fmt.Errorf(errGlobVar)
+				break main
 			}
 			top++
 			stack[top] = envVal.Value
@@ -861,7 +1019,8 @@ main:
 		case DIVFLOAT:
 			top--
 			if stack[top+1] == 0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			f := *(*float64)(unsafe.Pointer(&stack[top]))
 			f /= *(*float64)(unsafe.Pointer(&stack[top+1]))
@@ -893,7 +1052,8 @@ main:
 			f := *(*float64)(unsafe.Pointer(uintptr(stack[top-1])))
 			d := *(*float64)(unsafe.Pointer(&stack[top]))
 			if d == 0.0 {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			f /= d
 			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) = *(*int64)(unsafe.Pointer(&f))
@@ -960,7 +1120,8 @@ main:
 			top--
 			d := rt.Objects[stack[top+1]].(decimal.Decimal)
 			if d.IsZero() {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			rt.Objects = append(rt.Objects, rt.Objects[stack[top]].(decimal.Decimal).Div(d))
 			stack[top] = int64(len(rt.Objects) - 1)
@@ -993,7 +1154,8 @@ main:
 		case ASSIGNDIVMONEY:
 			d := rt.Objects[stack[top]].(decimal.Decimal)
 			if d.IsZero() {
-				return ``, gas, fmt.Errorf(errDivZero)
+				rerr = fmt.Errorf(errDivZero)
+				break main
 			}
 			ind := *(*int64)(unsafe.Pointer(uintptr(stack[top-1])))
 			rt.Objects = append(rt.Objects, rt.Objects[ind].(decimal.Decimal).Div(d))
@@ -1038,10 +1200,311 @@ main:
 			top -= 2
 			DebugPrintf("ASSIGNADDBYTES\n")
 
+		case INCVAR:
+			v, ok := addInt(Vars[code[i+1]], int64(int16(code[i+2])))
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			Vars[code[i+1]] = v
+			DebugPrintf("INCVAR    Vars_index: %d    %d\n", code[i+1], int16(code[i+2]))
+			i += 2
+
+		case COPYVAR:
+			Vars[code[i+1]] = Vars[code[i+2]]
+			DebugPrintf("COPYVAR    dest: %d    src: %d\n", code[i+1], code[i+2])
+			i += 2
+
+		case ACTION: // conditions结束
+			DebugPrintf("ACTION\n")
+			if rt.Validate {
+				return result, gas, nil
+			}
+
+		case TRY: // try块开始
+			tries = append(tries, tryBlock{Catch: i + int64(int16(code[i+1])), Var: code[i+2],
+				Top: top, Coff: coff})
+			i += 2
+			DebugPrintf("TRY    catch: %d\n", tries[len(tries)-1].Catch)
+
+		case ENDTRY: // try块结束
+			tries = tries[:len(tries)-1]
+			DebugPrintf("ENDTRY\n")
+
+		case THROW: // error(code, message)
+			rerr, origin = &Error{Code: rt.Strings[stack[top-1]], Message: rt.Strings[stack[top]],
+				Origin: OriginError}, OriginError
+			top -= 2
+			DebugPrintf("THROW\n")
+			break main
+
+		case ISSET: // 检查合约参数是否已传入
+			i++
+			top++
+			stack[top] = 0
+			for j := 0; j < len(params); j += 2 {
+				if params[j] == int64(code[i]) {
+					stack[top] = 1
+					break
+				}
+			}
+			DebugPrintf("ISSET    par_index: %d    %d\n", code[i], stack[top])
+
+		case NEWSTRUCT:
+			i++
+			top++
+			stack[top] = rt.newValue(contract, StructType(int(code[i])))
+			DebugPrintf("NEWSTRUCT    struct: %d\n", code[i])
+
+		case GETFIELD:
+			i++
+			stack[top] = rt.Objects[stack[top]].(*Struct).Values[code[i]]
+			DebugPrintf("GETFIELD    field: %d\n", code[i])
+
+		case SETFIELD:
+			i++
+			rt.Objects[stack[top-1]].(*Struct).Values[code[i]] = stack[top]
+			top -= 2
+			DebugPrintf("SETFIELD    field: %d\n", code[i])
+
+		case INITFIELD:
+			i++
+			rt.Objects[stack[top-1]].(*Struct).Values[code[i]] = stack[top]
+			top--
+			DebugPrintf("INITFIELD    field: %d\n", code[i])
+
+		case INTFLOAT:
+			i++
+			f := float64(stack[top-int64(code[i])])
+			stack[top-int64(code[i])] = *(*int64)(unsafe.Pointer(&f))
+			DebugPrintf("INTFLOAT    depth: %d\n", code[i])
+
+		case INTMONEY:
+			i++
+			rt.Objects = append(rt.Objects, decimal.New(stack[top-int64(code[i])], 0))
+			stack[top-int64(code[i])] = int64(len(rt.Objects) - 1)
+			DebugPrintf("INTMONEY    depth: %d\n", code[i])
+
+		case MODMONEY:
+			top--
+			d := rt.Objects[stack[top+1]].(decimal.Decimal)
+			if d.IsZero() {
+				rerr = fmt.Errorf(errDivZero)
+				break main
+			}
+			rt.Objects = append(rt.Objects, rt.Objects[stack[top]].(decimal.Decimal).Mod(d))
+			stack[top] = int64(len(rt.Objects) - 1)
+			DebugPrintf("MODMONEY\n")
+
+		case ASSIGNMODMONEY:
+			d := rt.Objects[stack[top]].(decimal.Decimal)
+			if d.IsZero() {
+				rerr = fmt.Errorf(errDivZero)
+				break main
+			}
+			ind := *(*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			rt.Objects = append(rt.Objects, rt.Objects[ind].(decimal.Decimal).Mod(d))
+			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) = int64(len(rt.Objects) - 1)
+			top -= 2
+			DebugPrintf("ASSIGNMODMONEY\n")
+
+		case LTSTR:
+			var b int64
+			top--
+			if rt.Strings[stack[top]] < rt.Strings[stack[top+1]] {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("LTSTR\n")
+
+		case GTSTR:
+			var b int64
+			top--
+			if rt.Strings[stack[top]] > rt.Strings[stack[top+1]] {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("GTSTR\n")
+
+		case EQBYTES:
+			var b int64
+			top--
+			if compareBytes(rt, stack[top], stack[top+1]) == 0 {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("EQBYTES\n")
+
+		case LTBYTES:
+			var b int64
+			top--
+			if compareBytes(rt, stack[top], stack[top+1]) < 0 {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("LTBYTES\n")
+
+		case GTBYTES:
+			var b int64
+			top--
+			if compareBytes(rt, stack[top], stack[top+1]) > 0 {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("GTBYTES\n")
+
+		case EQDEEP:
+			var b int64
+			i++
+			top--
+			if equal(rt, int64(code[i]), stack[top], stack[top+1]) {
+				b = 1
+			}
+			stack[top] = b
+			DebugPrintf("EQDEEP    type: %d\n", code[i])
+
+		case NOTINT:
+			stack[top] = ^stack[top]
+			DebugPrintf("NOTINT\n")
+
+		case ANDINT:
+			top--
+			stack[top] &= stack[top+1]
+			DebugPrintf("ANDINT\n")
+
+		case ORINT:
+			top--
+			stack[top] |= stack[top+1]
+			DebugPrintf("ORINT\n")
+
+		case XORINT:
+			top--
+			stack[top] ^= stack[top+1]
+			DebugPrintf("XORINT\n")
+
+		case SHLINT:
+			top--
+			if stack[top+1] < 0 {
+				rerr = fmt.Errorf(errShiftCount, stack[top+1])
+				break main
+			}
+			v, ok := shlInt(stack[top], stack[top+1])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			stack[top] = v
+			DebugPrintf("SHLINT\n")
+
+		case SHRINT:
+			top--
+			if stack[top+1] < 0 {
+				rerr = fmt.Errorf(errShiftCount, stack[top+1])
+				break main
+			}
+			stack[top] = shrInt(stack[top], stack[top+1])
+			DebugPrintf("SHRINT\n")
+
+		case ASSIGNANDINT:
+			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) &= stack[top]
+			top -= 2
+			DebugPrintf("ASSIGNANDINT\n")
+
+		case ASSIGNORINT:
+			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) |= stack[top]
+			top -= 2
+			DebugPrintf("ASSIGNORINT\n")
+
+		case ASSIGNXORINT:
+			*(*int64)(unsafe.Pointer(uintptr(stack[top-1]))) ^= stack[top]
+			top -= 2
+			DebugPrintf("ASSIGNXORINT\n")
+
+		case ASSIGNSHLINT:
+			if stack[top] < 0 {
+				rerr = fmt.Errorf(errShiftCount, stack[top])
+				break main
+			}
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			v, ok := shlInt(*ptr, stack[top])
+			if !ok && rt.Checked {
+				rerr = contract.overflow(i)
+				break main
+			}
+			*ptr = v
+			top -= 2
+			DebugPrintf("ASSIGNSHLINT\n")
+
+		case ASSIGNSHRINT:
+			if stack[top] < 0 {
+				rerr = fmt.Errorf(errShiftCount, stack[top])
+				break main
+			}
+			ptr := (*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			*ptr = shrInt(*ptr, stack[top])
+			top -= 2
+			DebugPrintf("ASSIGNSHRINT\n")
+
+		case NOTBYTES:
+			src := rt.Objects[stack[top]].([]byte)
+			ret := make([]byte, len(src))
+			for j, b := range src {
+				ret[j] = ^b
+			}
+			rt.Objects = append(rt.Objects, ret)
+			stack[top] = int64(len(rt.Objects) - 1)
+			DebugPrintf("NOTBYTES\n")
+
+		case ANDBYTES, ORBYTES, XORBYTES:
+			top--
+			a, b := rt.Objects[stack[top]].([]byte), rt.Objects[stack[top+1]].([]byte)
+			ret, ok := bitBytes(code[i], a, b)
+			if !ok {
+				rerr = fmt.Errorf(errBytesLen, len(a), len(b))
+				break main
+			}
+			rt.Objects = append(rt.Objects, ret)
+			stack[top] = int64(len(rt.Objects) - 1)
+			DebugPrintf("BITBYTES    %d\n", code[i])
+
+		case ASSIGNANDBYTES, ASSIGNORBYTES, ASSIGNXORBYTES:
+			ind := *(*int64)(unsafe.Pointer(uintptr(stack[top-1])))
+			a, b := rt.Objects[ind].([]byte), rt.Objects[stack[top]].([]byte)
+			ret, ok := bitBytes(code[i], a, b)
+			if !ok {
+				rerr = fmt.Errorf(errBytesLen, len(a), len(b))
+				break main
+			}
+			rt.Objects[ind] = ret
+			top -= 2
+			DebugPrintf("ASSIGNBITBYTES    %d\n", code[i])
+
 		default:
 			return ``, gas, fmt.Errorf(errCommand, code[i])
 		}
 		i++
 	}
+	if rerr != nil {
+		if len(tries) == 0 {
+			return ``, gas, rerr
+		}
+		// the error is caught by the latest try block
+		try := tries[len(tries)-1]
+		tries = tries[:len(tries)-1]
+		if isParContract {
+			delCount(false)
+			isParContract = false
+		}
+		pars = pars[:0]
+		top, coff, i = try.Top, try.Coff, try.Catch
+		e := newError(rerr, origin)
+		rt.Strings = append(rt.Strings, e.Code, e.Message, e.Origin)
+		last := int64(len(rt.Strings))
+		rt.Objects = append(rt.Objects, map[string]int64{`code`: last - 3, `message`: last - 2,
+			`origin`: last - 1})
+		Vars[try.Var] = int64(len(rt.Objects) - 1)
+		rerr, origin = nil, ``
+		goto main
+	}
 	return result, gas, nil
 }
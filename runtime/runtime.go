@@ -99,14 +99,61 @@ const (
 	LTMONEY        // money < money
 	GTMONEY        // money > money
 	ASSIGNADDBYTES // vars += bytes
+	INCVAR         // + uint16 + int16 vars[index] += int16
+	COPYVAR        // + uint16 + uint16 vars[dest] = vars[src]
 
-	DATA // +uint16 size of data + data
+	DATA   // +uint16 size of data + data
+	ISSET  // +uint16 index of the data parameter, pushes true if the parameter has been passed
+	ACTION // the end of the conditions, VM.Validate stops here
+	TRY    // + int16 offset of catch + uint16 index of the error variable
+	ENDTRY // the end of the try block
+	THROW  // error(code, message)
+
+	NEWSTRUCT // + uint16 index of the struct type, pushes the new struct
+	GETFIELD  // + uint16 index of the field, struct => value of the field
+	SETFIELD  // + uint16 index of the field, struct.field = value
+	INITFIELD // + uint16 index of the field, the struct is left on the stack
+
+	INTFLOAT       // + uint16 depth, converts int to float, 0 is the top of the stack
+	INTMONEY       // + uint16 depth, converts int to money, 0 is the top of the stack
+	MODMONEY       // money % money
+	ASSIGNMODMONEY // money %= money
+	LTSTR          // str < str
+	GTSTR          // str > str
+	EQBYTES        // bytes == bytes
+	LTBYTES        // bytes < bytes
+	GTBYTES        // bytes > bytes
+	EQDEEP         // + uint16 type, the deep equality of arr, map and obj
+
+	NOTINT         // ~int
+	ANDINT         // int & int
+	ORINT          // int | int
+	XORINT         // int ^ int
+	SHLINT         // int << int
+	SHRINT         // int >> int
+	ASSIGNANDINT   // int &= int
+	ASSIGNORINT    // int |= int
+	ASSIGNXORINT   // int ^= int
+	ASSIGNSHLINT   // int <<= int
+	ASSIGNSHRINT   // int >>= int
+	NOTBYTES       // ~bytes
+	ANDBYTES       // bytes & bytes, the lengths must be equal
+	ORBYTES        // bytes | bytes
+	XORBYTES       // bytes ^ bytes
+	ASSIGNANDBYTES // bytes &= bytes
+	ASSIGNORBYTES  // bytes |= bytes
+	ASSIGNXORBYTES // bytes ^= bytes
+
+	DUP2      // duplicates two values on the top of the stack
+	GETMAPDEF // + uint16 type, map[key] or the default value if the key doesn't exist
+	SLICE     // + uint16 type + uint16 1 if the end is specified, arr[from:end] bytes[from:end] str[from:end]
+	SPRINTF   // + uint16 count + count * uint16 type, Sprintf(format, values...)
 )
 
 // VarInfo describes a variable
 type VarInfo struct {
 	Index uint16
-	Type  uint16
+	Type  uint32 // the struct types have the index of the struct in the upper 16 bits
 }
 
 type Var struct {
@@ -116,22 +163,47 @@ type Var struct {
 
 // FuncInfo describes a function
 type FuncInfo struct {
-	Offset int
-	Result int64
-	Name   string
-	Params []Var
+	Offset  int
+	Result  int64
+	Results []int64 // the types of the multiple results, Result is void then
+	Name    string
+	Params  []Var
+}
+
+// LoopInfo describes a loop for the static estimation of gas
+type LoopInfo struct {
+	Offset int   // the offset of the loop condition in the code
+	Count  int64 // the maximum count of iterations or -1 if it is unknown
+	Line   int
+	Column uint32
+}
+
+// Position is the position in the source of the instruction which can raise the runtime error
+type Position struct {
+	Offset int // the offset of the instruction in the code
+	Line   int
+	Column uint32
 }
 
 // Contract contains information about the contract
 type Contract struct {
-	ID       int64 // External id
-	Name     string
-	Read     bool
-	Code     []Bcode
-	Vars     map[string]VarInfo
-	VarsList []VarInfo
-	Funcs    []*FuncInfo	// 保存函数信息的表，编译时和运行时都会使用
-	Params   map[string]VarInfo
+	ID         int64 // External id
+	Version    int   // the version of the contract in VM, starting with 1
+	Name       string
+	Read       bool
+	Code       []Bcode
+	Vars       map[string]VarInfo
+	VarsList   []VarInfo
+	Funcs      []*FuncInfo // 保存函数信息的表，编译时和运行时都会使用
+	Params     map[string]VarInfo
+	Loops      []LoopInfo
+	Calls      []string // the names of the called contracts and the imported libraries
+	Library    bool
+	Attrs      map[string]*ParamAttr // the attributes of the data parameters
+	Conditions bool                  // the contract has the conditions section
+	Source     string                // the original source of the library which is compiled by the importing contracts
+	Structs    []*StructInfo         // the struct types declared in the contract
+	Positions  []Position            // the positions of the integer operations sorted by the offset
 }
 
 type EnvItem struct {
@@ -144,13 +216,15 @@ type FuncItem struct {
 	Result uint32
 	Params []uint32
 	Read   bool
+	Gas    int64 // the maximum gas of the function for the static estimation, 0 if it is unknown
 	Func   interface{}
 }
 
 // Custom is a structure for compile customizing
 type Custom struct {
-	Env   map[string]EnvItem
-	Funcs []FuncItem
+	Env      map[string]EnvItem
+	Funcs    []FuncItem
+	Optimize bool // run the optimization passes after compiling
 }
 
 type EnvVal struct {
@@ -172,7 +246,11 @@ type Runtime struct {
 	Objects   []interface{}
 	Data      IData
 	Funcs     []FuncItem
+	Validate  bool // only the conditions of the contract are executed
 	Env       []EnvVal
+	Checked   bool                // the integer overflow raises the error instead of wrapping around
+	Cost      int64               // the gas of the embedded function which depends on the size of the data
+	Patterns  map[string]*Pattern // the regular expressions which have been compiled during the run
 }
 
 // NewRuntime creates a new runtime
@@ -227,6 +305,8 @@ func print(rt *Runtime, val int64, vtype int64) string {
 		result += strings.Join(items, ` `) + `]`
 	case parser.VMoney:
 		result = fmt.Sprint(rt.Objects[val].(decimal.Decimal))
+	case parser.VStruct:
+		result = printStruct(rt, rt.Objects[val].(*Struct))
 	case parser.VObject:
 		result = fmt.Sprint(rt.Objects[val].(*types.Map))
 	default:
@@ -268,6 +348,8 @@ func copy(rt *Runtime, vtype int64, index int64) int64 {
 		fvar := rt.Objects[index].(*types.File)
 		rt.Objects = append(rt.Objects, types.FileInit(fvar.Name, fvar.MimeType, fvar.Body))
 		return int64(len(rt.Objects) - 1)
+	case parser.VStruct:
+		return copyStruct(rt, rt.Objects[index].(*Struct))
 	}
 	return index
 }
@@ -0,0 +1,67 @@
+package runtime
+
+import (
+	"fmt"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const errSliceOut = `slice bounds out of range [%d:%d] len:%d`
+
+// itemIndex returns the index of the item, the negative index is counted from the end
+func itemIndex(idx int64, length int) (int64, bool) {
+	if idx < 0 {
+		idx += int64(length)
+	}
+	return idx, idx >= 0 && idx < int64(length)
+}
+
+// slice returns the copy of the part of arr, bytes or str and the count of the copied items.
+// The negative bounds are counted from the end. The items of the array are copied too
+// so the slice doesn't share the objects with the source array.
+func (rt *Runtime) slice(vtype, obj, from, end int64, hasEnd bool) (int64, int64, error) {
+	var (
+		length int
+		runes  []rune
+	)
+	switch vtype & 0xf {
+	case parser.VStr:
+		runes = []rune(rt.Strings[obj])
+		length = len(runes)
+	case parser.VArr:
+		length = len(rt.Objects[obj].([]int64))
+	case parser.VBytes:
+		length = len(rt.Objects[obj].([]byte))
+	}
+	start, stop := from, int64(length)
+	if hasEnd {
+		stop = end
+	}
+	if start < 0 {
+		start += int64(length)
+	}
+	if stop < 0 {
+		stop += int64(length)
+	}
+	if start < 0 || stop > int64(length) || start > stop {
+		if !hasEnd {
+			end = int64(length)
+		}
+		return 0, 0, fmt.Errorf(errSliceOut, from, end, length)
+	}
+	switch vtype & 0xf {
+	case parser.VStr:
+		rt.Strings = append(rt.Strings, string(runes[start:stop]))
+		return int64(len(rt.Strings) - 1), stop - start, nil
+	case parser.VArr:
+		src := rt.Objects[obj].([]int64)[start:stop]
+		ret := make([]int64, len(src))
+		for i, item := range src {
+			ret[i] = copy(rt, vtype>>4, item)
+		}
+		rt.Objects = append(rt.Objects, ret)
+	case parser.VBytes:
+		rt.Objects = append(rt.Objects, append([]byte{}, rt.Objects[obj].([]byte)[start:stop]...))
+	}
+	return int64(len(rt.Objects) - 1), stop - start, nil
+}
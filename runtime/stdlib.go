@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"unicode/utf8"
 	"unsafe"
 
 	"github.com/shopspring/decimal"
@@ -89,6 +90,145 @@ var (
 		{5, FileInit, 3, `FileInit`, []uint32{parser.VStr, parser.VStr, parser.VBytes},
 			parser.VFile}, // FileInit(str str bytes) file
 		{7, Sha256, 1, `Sha256`, []uint32{parser.VBytes}, parser.VBytes}, // Sha256(bytes) bytes
+		// 字符串函数
+		{5, HasSuffix, 2, `HasSuffix`, []uint32{parser.VStr, parser.VStr},
+			parser.VBool}, // HasSuffix(str, str) bool
+		{5, Index, 2, `Index`, []uint32{parser.VStr, parser.VStr},
+			parser.VInt}, // Index(str, str) int
+		{5, LastIndex, 2, `LastIndex`, []uint32{parser.VStr, parser.VStr},
+			parser.VInt}, // LastIndex(str, str) int
+		{5, TrimPrefix, 2, `TrimPrefix`, []uint32{parser.VStr, parser.VStr},
+			parser.VStr}, // TrimPrefix(str, str) str
+		{5, TrimSuffix, 2, `TrimSuffix`, []uint32{parser.VStr, parser.VStr},
+			parser.VStr}, // TrimSuffix(str, str) str
+		{5, Repeat, 2, `Repeat`, []uint32{parser.VStr, parser.VInt},
+			parser.VStr}, // Repeat(str, int) str
+		{5, PadLeft, 3, `PadLeft`, []uint32{parser.VStr, parser.VInt, parser.VStr},
+			parser.VStr}, // PadLeft(str, int, str) str
+		{5, PadRight, 3, `PadRight`, []uint32{parser.VStr, parser.VInt, parser.VStr},
+			parser.VStr}, // PadRight(str, int, str) str
+		{5, Count, 2, `Count`, []uint32{parser.VStr, parser.VStr},
+			parser.VInt}, // Count(str, str) int
+		{7, Fields, 1, `Fields`, []uint32{parser.VStr},
+			(parser.VStr << 4) | parser.VArr}, // Fields(str) arr.str
+		// 正则表达式函数，gas取决于表达式的复杂度和字符串的长度
+		{5, RegexpMatch, 2, `RegexpMatch`, []uint32{parser.VStr, parser.VStr},
+			parser.VBool}, // RegexpMatch(str, str) bool
+		{5, RegexpFind, 2, `RegexpFind`, []uint32{parser.VStr, parser.VStr},
+			parser.VStr}, // RegexpFind(str, str) str
+		{5, RegexpFindAll, 2, `RegexpFindAll`, []uint32{parser.VStr, parser.VStr},
+			(parser.VStr << 4) | parser.VArr}, // RegexpFindAll(str, str) arr.str
+		{5, RegexpReplace, 3, `RegexpReplace`, []uint32{parser.VStr, parser.VStr, parser.VStr},
+			parser.VStr}, // RegexpReplace(str, str, str) str
+		{5, RegexpSplit, 2, `RegexpSplit`, []uint32{parser.VStr, parser.VStr},
+			(parser.VStr << 4) | parser.VArr}, // RegexpSplit(str, str) arr.str
+		// 货币函数，小数位数不能超过 MaxPlaces
+		{7, MoneyFloatPlaces, 2, `money`, []uint32{parser.VFloat, parser.VInt},
+			parser.VMoney}, // money(float, int) money
+		{7, MoneyStrPlaces, 2, `money`, []uint32{parser.VStr, parser.VInt},
+			parser.VMoney}, // money(str, int) money
+		{5, IntMoney, 1, `int`, []uint32{parser.VMoney}, parser.VInt},       // int(money) int
+		{5, FloatMoney, 1, `float`, []uint32{parser.VMoney}, parser.VFloat}, // float(money) float
+		{5, RoundMoney, 2, `Round`, []uint32{parser.VMoney, parser.VInt},
+			parser.VMoney}, // Round(money, int) money
+		{5, RoundBank, 2, `RoundBank`, []uint32{parser.VMoney, parser.VInt},
+			parser.VMoney}, // RoundBank(money, int) money
+		{5, Truncate, 2, `Truncate`, []uint32{parser.VMoney, parser.VInt},
+			parser.VMoney}, // Truncate(money, int) money
+		{5, CeilMoney, 1, `Ceil`, []uint32{parser.VMoney}, parser.VMoney}, // Ceil(money) money
+		{5, AbsMoney, 1, `Abs`, []uint32{parser.VMoney}, parser.VMoney},   // Abs(money) money
+		{20, PowMoney, 2, `Pow`, []uint32{parser.VMoney, parser.VInt},
+			parser.VMoney}, // Pow(money, int) money
+		{5, Percent, 2, `Percent`, []uint32{parser.VMoney, parser.VInt},
+			parser.VMoney}, // Percent(money, int) money
+		{5, CmpMoney, 2, `Cmp`, []uint32{parser.VMoney, parser.VMoney},
+			parser.VInt}, // Cmp(money, money) int
+		{10, FormatMoney, 3, `FormatMoney`, []uint32{parser.VMoney, parser.VInt, parser.VStr},
+			parser.VStr}, // FormatMoney(money, int, str) str
+		// 集合函数，gas取决于集合的大小
+		{5, DeleteMap, 2, `Delete`, []uint32{parser.VMap, parser.VStr},
+			parser.VVoid}, // Delete(map, str)
+		{5, HasKeyMap, 2, `HasKey`, []uint32{parser.VMap, parser.VStr},
+			parser.VBool}, // HasKey(map, str) bool
+		{10, ValuesInt, 1, `Values`, []uint32{(parser.VInt << 4) | parser.VMap},
+			(parser.VInt << 4) | parser.VArr}, // Values(map.int) arr.int
+		{10, ValuesBool, 1, `Values`, []uint32{(parser.VBool << 4) | parser.VMap},
+			(parser.VBool << 4) | parser.VArr}, // Values(map.bool) arr.bool
+		{10, ValuesStr, 1, `Values`, []uint32{(parser.VStr << 4) | parser.VMap},
+			(parser.VStr << 4) | parser.VArr}, // Values(map.str) arr.str
+		{10, ValuesFloat, 1, `Values`, []uint32{(parser.VFloat << 4) | parser.VMap},
+			(parser.VFloat << 4) | parser.VArr}, // Values(map.float) arr.float
+		{10, ValuesMoney, 1, `Values`, []uint32{(parser.VMoney << 4) | parser.VMap},
+			(parser.VMoney << 4) | parser.VArr}, // Values(map.money) arr.money
+		{10, SortInt, 1, `Sort`, []uint32{(parser.VInt << 4) | parser.VArr},
+			parser.VVoid}, // Sort(arr.int)
+		{10, SortStr, 1, `Sort`, []uint32{(parser.VStr << 4) | parser.VArr},
+			parser.VVoid}, // Sort(arr.str)
+		{10, SortFloat, 1, `Sort`, []uint32{(parser.VFloat << 4) | parser.VArr},
+			parser.VVoid}, // Sort(arr.float)
+		{10, SortMoney, 1, `Sort`, []uint32{(parser.VMoney << 4) | parser.VArr},
+			parser.VVoid}, // Sort(arr.money)
+		{5, ReverseArr, 1, `Reverse`, []uint32{parser.VArr}, parser.VVoid}, // Reverse(arr)
+		{5, IndexOfInt, 2, `IndexOf`, []uint32{(parser.VInt << 4) | parser.VArr, parser.VInt},
+			parser.VInt}, // IndexOf(arr.int, int) int
+		{5, IndexOfBool, 2, `IndexOf`, []uint32{(parser.VBool << 4) | parser.VArr, parser.VBool},
+			parser.VInt}, // IndexOf(arr.bool, bool) int
+		{5, IndexOfStr, 2, `IndexOf`, []uint32{(parser.VStr << 4) | parser.VArr, parser.VStr},
+			parser.VInt}, // IndexOf(arr.str, str) int
+		{5, IndexOfFloat, 2, `IndexOf`, []uint32{(parser.VFloat << 4) | parser.VArr, parser.VFloat},
+			parser.VInt}, // IndexOf(arr.float, float) int
+		{5, IndexOfMoney, 2, `IndexOf`, []uint32{(parser.VMoney << 4) | parser.VArr, parser.VMoney},
+			parser.VInt}, // IndexOf(arr.money, money) int
+		{5, ContainsInt, 2, `Contains`, []uint32{(parser.VInt << 4) | parser.VArr, parser.VInt},
+			parser.VBool}, // Contains(arr.int, int) bool
+		{5, ContainsBool, 2, `Contains`, []uint32{(parser.VBool << 4) | parser.VArr, parser.VBool},
+			parser.VBool}, // Contains(arr.bool, bool) bool
+		{5, ContainsStr, 2, `Contains`, []uint32{(parser.VStr << 4) | parser.VArr, parser.VStr},
+			parser.VBool}, // Contains(arr.str, str) bool
+		{5, ContainsFloat, 2, `Contains`, []uint32{(parser.VFloat << 4) | parser.VArr, parser.VFloat},
+			parser.VBool}, // Contains(arr.float, float) bool
+		{5, ContainsMoney, 2, `Contains`, []uint32{(parser.VMoney << 4) | parser.VArr, parser.VMoney},
+			parser.VBool}, // Contains(arr.money, money) bool
+		{5, RemoveArr, 2, `Remove`, []uint32{parser.VArr, parser.VInt},
+			parser.VVoid}, // Remove(arr, int)
+		{5, InsertInt, 3, `Insert`, []uint32{(parser.VInt << 4) | parser.VArr, parser.VInt, parser.VInt},
+			parser.VVoid}, // Insert(arr.int, int, int)
+		{5, InsertBool, 3, `Insert`, []uint32{(parser.VBool << 4) | parser.VArr, parser.VInt, parser.VBool},
+			parser.VVoid}, // Insert(arr.bool, int, bool)
+		{5, InsertStr, 3, `Insert`, []uint32{(parser.VStr << 4) | parser.VArr, parser.VInt, parser.VStr},
+			parser.VVoid}, // Insert(arr.str, int, str)
+		{5, InsertFloat, 3, `Insert`, []uint32{(parser.VFloat << 4) | parser.VArr, parser.VInt, parser.VFloat},
+			parser.VVoid}, // Insert(arr.float, int, float)
+		{5, InsertMoney, 3, `Insert`, []uint32{(parser.VMoney << 4) | parser.VArr, parser.VInt, parser.VMoney},
+			parser.VVoid}, // Insert(arr.money, int, money)
+		{10, UniqueInt, 1, `Unique`, []uint32{(parser.VInt << 4) | parser.VArr},
+			parser.VVoid}, // Unique(arr.int)
+		{10, UniqueBool, 1, `Unique`, []uint32{(parser.VBool << 4) | parser.VArr},
+			parser.VVoid}, // Unique(arr.bool)
+		{10, UniqueStr, 1, `Unique`, []uint32{(parser.VStr << 4) | parser.VArr},
+			parser.VVoid}, // Unique(arr.str)
+		{10, UniqueFloat, 1, `Unique`, []uint32{(parser.VFloat << 4) | parser.VArr},
+			parser.VVoid}, // Unique(arr.float)
+		{10, UniqueMoney, 1, `Unique`, []uint32{(parser.VMoney << 4) | parser.VArr},
+			parser.VVoid}, // Unique(arr.money)
+		{5, SumInt, 1, `Sum`, []uint32{(parser.VInt << 4) | parser.VArr},
+			parser.VInt}, // Sum(arr.int) int
+		{5, SumFloat, 1, `Sum`, []uint32{(parser.VFloat << 4) | parser.VArr},
+			parser.VFloat}, // Sum(arr.float) float
+		{5, SumMoney, 1, `Sum`, []uint32{(parser.VMoney << 4) | parser.VArr},
+			parser.VMoney}, // Sum(arr.money) money
+		{5, MinInt, 1, `Min`, []uint32{(parser.VInt << 4) | parser.VArr},
+			parser.VInt}, // Min(arr.int) int
+		{5, MinFloat, 1, `Min`, []uint32{(parser.VFloat << 4) | parser.VArr},
+			parser.VFloat}, // Min(arr.float) float
+		{5, MinMoney, 1, `Min`, []uint32{(parser.VMoney << 4) | parser.VArr},
+			parser.VMoney}, // Min(arr.money) money
+		{5, MaxInt, 1, `Max`, []uint32{(parser.VInt << 4) | parser.VArr},
+			parser.VInt}, // Max(arr.int) int
+		{5, MaxFloat, 1, `Max`, []uint32{(parser.VFloat << 4) | parser.VArr},
+			parser.VFloat}, // Max(arr.float) float
+		{5, MaxMoney, 1, `Max`, []uint32{(parser.VMoney << 4) | parser.VArr},
+			parser.VMoney}, // Max(arr.money) money
 	}
 )
 
@@ -121,9 +261,9 @@ func LenMap(rt *Runtime, i int64) int64 {
 	return int64(len(rt.Objects[i].(map[string]int64)))
 }
 
-// LenStr returns the length of the string
+// LenStr returns the count of runes in the string, like Substr, Index and PadLeft count them
 func LenStr(rt *Runtime, i int64) int64 {
-	return int64(len(rt.Strings[i]))
+	return int64(utf8.RuneCountInString(rt.Strings[i]))
 }
 
 // IntStr converts a string to the integer number
@@ -3,6 +3,14 @@ package runtime
 import (
 	"fmt"
 	"strings"
+	"unicode/utf8"
+)
+
+const (
+	errRepeatCount = `negative repeat count %d`
+	errStrLen      = `the string cannot be longer than %d bytes`
+
+	maxStrLen = 1 << 20 // the maximum length of the string made by Repeat, PadLeft or PadRight
 )
 
 // Replace replaces old substrings to new substrings
@@ -85,3 +93,107 @@ func ToUpper(rt *Runtime, s int64) int64 {
 	rt.Strings = append(rt.Strings, strings.ToUpper(rt.Strings[s]))
 	return int64(len(rt.Strings) - 1)
 }
+
+// HasSuffix returns true if s ends with suffix
+func HasSuffix(rt *Runtime, s, suffix int64) int64 {
+	if strings.HasSuffix(rt.Strings[s], rt.Strings[suffix]) {
+		return 1
+	}
+	return 0
+}
+
+// Index returns the index of the first rune of substr in s or -1
+func Index(rt *Runtime, s, substr int64) int64 {
+	off := strings.Index(rt.Strings[s], rt.Strings[substr])
+	if off < 0 {
+		return -1
+	}
+	return int64(utf8.RuneCountInString(rt.Strings[s][:off]))
+}
+
+// LastIndex returns the rune index of the last substr in s or -1
+func LastIndex(rt *Runtime, s, substr int64) int64 {
+	off := strings.LastIndex(rt.Strings[s], rt.Strings[substr])
+	if off < 0 {
+		return -1
+	}
+	return int64(utf8.RuneCountInString(rt.Strings[s][:off]))
+}
+
+// TrimPrefix returns s without the leading prefix
+func TrimPrefix(rt *Runtime, s, prefix int64) int64 {
+	rt.Strings = append(rt.Strings, strings.TrimPrefix(rt.Strings[s], rt.Strings[prefix]))
+	return int64(len(rt.Strings) - 1)
+}
+
+// TrimSuffix returns s without the trailing suffix
+func TrimSuffix(rt *Runtime, s, suffix int64) int64 {
+	rt.Strings = append(rt.Strings, strings.TrimSuffix(rt.Strings[s], rt.Strings[suffix]))
+	return int64(len(rt.Strings) - 1)
+}
+
+// Repeat returns the string which consists of count copies of s
+func Repeat(rt *Runtime, s, count int64) (int64, error) {
+	if count < 0 {
+		return 0, fmt.Errorf(errRepeatCount, count)
+	}
+	if len(rt.Strings[s]) > 0 && count > maxStrLen/int64(len(rt.Strings[s])) {
+		return 0, fmt.Errorf(errStrLen, maxStrLen)
+	}
+	ret := strings.Repeat(rt.Strings[s], int(count))
+	rt.spend(len(ret))
+	rt.Strings = append(rt.Strings, ret)
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// padding returns the runes of pad which are added to s to get the specified width
+func padding(rt *Runtime, s, width, pad int64) (string, error) {
+	if width > maxStrLen {
+		return ``, fmt.Errorf(errStrLen, maxStrLen)
+	}
+	count := int(width) - utf8.RuneCountInString(rt.Strings[s])
+	if count <= 0 || len(rt.Strings[pad]) == 0 {
+		return ``, nil
+	}
+	runes := []rune(strings.Repeat(rt.Strings[pad], count/utf8.RuneCountInString(rt.Strings[pad])+1))
+	ret := string(runes[:count])
+	rt.spend(len(ret))
+	return ret, nil
+}
+
+// PadLeft adds pad to the beginning of s until the string has width runes
+func PadLeft(rt *Runtime, s, width, pad int64) (int64, error) {
+	prefix, err := padding(rt, s, width, pad)
+	if err != nil {
+		return 0, err
+	}
+	rt.Strings = append(rt.Strings, prefix+rt.Strings[s])
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// PadRight adds pad to the end of s until the string has width runes
+func PadRight(rt *Runtime, s, width, pad int64) (int64, error) {
+	suffix, err := padding(rt, s, width, pad)
+	if err != nil {
+		return 0, err
+	}
+	rt.Strings = append(rt.Strings, rt.Strings[s]+suffix)
+	return int64(len(rt.Strings) - 1), nil
+}
+
+// Count returns the count of non-overlapping substr in s
+func Count(rt *Runtime, s, substr int64) int64 {
+	return int64(strings.Count(rt.Strings[s], rt.Strings[substr]))
+}
+
+// Fields splits s by the white spaces
+func Fields(rt *Runtime, s int64) int64 {
+	out := strings.Fields(rt.Strings[s])
+	result := make([]int64, len(out))
+	for i, val := range out {
+		rt.Strings = append(rt.Strings, val)
+		result[i] = int64(len(rt.Strings) - 1)
+	}
+	rt.Objects = append(rt.Objects, result)
+	return int64(len(rt.Objects) - 1)
+}
@@ -0,0 +1,217 @@
+package runtime
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"unsafe"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/types"
+)
+
+const (
+	errStructField = `Struct %s doesn't have %s field`
+	errStructValue = `Invalid value of %s field`
+	errStructParam = `Parameter %s must be json object`
+)
+
+// StructInfo describes the struct type which is declared in the contract
+type StructInfo struct {
+	Name   string
+	Fields []Var
+}
+
+// Struct is the value of the struct type. The values of the fields are stored in the same
+// way as the values of the variables.
+type Struct struct {
+	Info   *StructInfo
+	Values []int64
+}
+
+// Field returns the index of the field or -1 if the struct doesn't have it
+func (sinfo *StructInfo) Field(name string) int {
+	for i, field := range sinfo.Fields {
+		if field.Name == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// IsStruct returns true if the type is the struct type
+func IsStruct(vtype int64) bool {
+	return vtype&0xf == parser.VStruct
+}
+
+// StructIndex returns the index of the struct in Contract.Structs
+func StructIndex(vtype int64) int {
+	return int(vtype >> 16)
+}
+
+// StructType returns the type of the struct with the specified index
+func StructType(idx int) int64 {
+	return parser.VStruct | int64(idx)<<16
+}
+
+// StructName returns the name of the struct type or the name of the type if it isn't a struct
+func (cnt *Contract) StructName(vtype int64) string {
+	if IsStruct(vtype) && StructIndex(vtype) < len(cnt.Structs) {
+		return cnt.Structs[StructIndex(vtype)].Name
+	}
+	return TypeName(vtype)
+}
+
+// newValue creates the default value of the specified type
+func (rt *Runtime) newValue(cnt *Contract, vtype int64) int64 {
+	var obj interface{}
+	switch vtype & 0xf {
+	case parser.VStr:
+		rt.Strings = append(rt.Strings, ``)
+		return int64(len(rt.Strings) - 1)
+	case parser.VArr:
+		obj = []int64{}
+	case parser.VMap:
+		obj = map[string]int64{}
+	case parser.VMoney:
+		obj = decimal.New(0, 0)
+	case parser.VBytes:
+		obj = []byte{}
+	case parser.VFile:
+		obj = types.NewFile()
+	case parser.VObject:
+		obj = types.NewMap()
+	case parser.VStruct:
+		sinfo := cnt.Structs[StructIndex(vtype)]
+		values := make([]int64, len(sinfo.Fields))
+		for i, field := range sinfo.Fields {
+			values[i] = rt.newValue(cnt, field.Type)
+		}
+		obj = &Struct{Info: sinfo, Values: values}
+	default:
+		return 0
+	}
+	rt.Objects = append(rt.Objects, obj)
+	return int64(len(rt.Objects) - 1)
+}
+
+// StructParam converts the json object which is passed by the host to the struct value
+func (rt *Runtime) StructParam(cnt *Contract, vtype int64, name, input string) (int64, error) {
+	var v interface{}
+	if err := json.Unmarshal([]byte(input), &v); err != nil {
+		return 0, fmt.Errorf(errStructParam, name)
+	}
+	return rt.jsonValue(cnt, vtype, name, v)
+}
+
+// jsonValue converts the decoded json value to the value of the specified type
+func (rt *Runtime) jsonValue(cnt *Contract, vtype int64, name string, v interface{}) (int64, error) {
+	var obj interface{}
+	switch vtype & 0xf {
+	case parser.VInt:
+		if f, ok := v.(float64); ok && f == float64(int64(f)) {
+			return int64(f), nil
+		}
+	case parser.VBool:
+		if b, ok := v.(bool); ok {
+			if b {
+				return 1, nil
+			}
+			return 0, nil
+		}
+	case parser.VFloat:
+		if f, ok := v.(float64); ok {
+			return *(*int64)(unsafe.Pointer(&f)), nil
+		}
+	case parser.VStr:
+		if s, ok := v.(string); ok {
+			rt.Strings = append(rt.Strings, s)
+			return int64(len(rt.Strings) - 1), nil
+		}
+	case parser.VMoney:
+		var (
+			d   decimal.Decimal
+			err error
+		)
+		switch val := v.(type) {
+		case string:
+			d, err = decimal.NewFromString(val)
+		case float64:
+			d = decimal.NewFromFloat(val)
+		default:
+			err = fmt.Errorf(errStructValue, name)
+		}
+		if err == nil {
+			obj = d.Floor()
+		}
+	case parser.VArr:
+		if list, ok := v.([]interface{}); ok {
+			iarr := make([]int64, len(list))
+			for i, item := range list {
+				val, err := rt.jsonValue(cnt, vtype>>4, name, item)
+				if err != nil {
+					return 0, err
+				}
+				iarr[i] = val
+			}
+			obj = iarr
+		}
+	case parser.VMap:
+		if items, ok := v.(map[string]interface{}); ok {
+			imap := make(map[string]int64)
+			for key, item := range items {
+				val, err := rt.jsonValue(cnt, vtype>>4, name, item)
+				if err != nil {
+					return 0, err
+				}
+				imap[key] = val
+			}
+			obj = imap
+		}
+	case parser.VStruct:
+		items, ok := v.(map[string]interface{})
+		if !ok {
+			break
+		}
+		ret := rt.newValue(cnt, vtype)
+		st := rt.Objects[ret].(*Struct)
+		for key, item := range items {
+			ind := st.Info.Field(key)
+			if ind < 0 {
+				return 0, fmt.Errorf(errStructField, st.Info.Name, key)
+			}
+			val, err := rt.jsonValue(cnt, st.Info.Fields[ind].Type, key, item)
+			if err != nil {
+				return 0, err
+			}
+			st.Values[ind] = val
+		}
+		return ret, nil
+	}
+	if obj == nil {
+		return 0, fmt.Errorf(errStructValue, name)
+	}
+	rt.Objects = append(rt.Objects, obj)
+	return int64(len(rt.Objects) - 1), nil
+}
+
+// printStruct returns the string representation of the struct like {id: x amount: 5}
+func printStruct(rt *Runtime, st *Struct) string {
+	items := make([]string, len(st.Values))
+	for i, field := range st.Info.Fields {
+		items[i] = field.Name + `: ` + print(rt, st.Values[i], field.Type)
+	}
+	return `{` + strings.Join(items, ` `) + `}`
+}
+
+// copyStruct makes the deep copy of the struct
+func copyStruct(rt *Runtime, st *Struct) int64 {
+	values := make([]int64, len(st.Values))
+	for i, field := range st.Info.Fields {
+		values[i] = copy(rt, field.Type, st.Values[i])
+	}
+	rt.Objects = append(rt.Objects, &Struct{Info: st.Info, Values: values})
+	return int64(len(rt.Objects) - 1)
+}
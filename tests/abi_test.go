@@ -0,0 +1,52 @@
+package test
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+type paramsData map[string]interface{}
+
+func (data paramsData) GetEnv() []interface{} {
+	return newData().GetEnv()
+}
+
+func (data paramsData) GetParam(name string) interface{} {
+	return data[name]
+}
+
+func TestABI(t *testing.T) {
+	vm := newVM(false)
+	if err := vm.LoadContract("contract abiTest read {\r\n    data {\r\n        str name\r\n"+
+		"        arr.map.str list\r\n        int count\r\n        money sum\r\n    }\r\n"+
+		"    func fullName(str first last) str {\r\n        return first + last\r\n    }\r\n"+
+		"    func nothing() {\r\n    }\r\n    return name + str(count)\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	abi := vm.GetContract(`abiTest`).ABI()
+	out, err := json.Marshal(abi)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := `{"name":"abiTest","read":true,"params":[{"name":"name","type":"str"},` +
+		`{"name":"list","type":"arr.map.str"},{"name":"count","type":"int"},{"name":"sum","type":"money"}],` +
+		`"funcs":[{"name":"fullName","params":[{"name":"first","type":"str"},{"name":"last","type":"str"}],` +
+		`"result":"str"},{"name":"nothing","params":[]}]}`
+	if string(out) != want {
+		t.Errorf("wrong ABI %s", out)
+	}
+	for _, item := range []struct {
+		Data paramsData
+		Err  string
+	}{
+		{paramsData{`name`: `John`, `count`: `10`, `sum`: `1.5`}, ``},
+		{paramsData{`count`: `ten`}, `Parameter count must have int type`},
+		{paramsData{`sum`: []byte{1}}, `Parameter sum must have money type`},
+		{paramsData{`list`: `[]`}, `Parameter list has unsupported type arr.map.str`},
+	} {
+		err := abi.CheckParams(item.Data)
+		if (err == nil && len(item.Err) > 0) || (err != nil && err.Error() != item.Err) {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+}
@@ -0,0 +1,149 @@
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestAnalyze(t *testing.T) {
+	vm := newVM(false)
+	for _, item := range []struct {
+		Source string
+		Want   []string
+	}{
+		{`contract aUnused {
+    data {
+        int amount
+        str unused
+    }
+    int x
+    int y = amount
+    x = 10
+    return str(y)
+}`, []string{
+			`aUnused 4:9: Data parameter unused is not used`,
+			`aUnused 6:5: Variable x is declared but not used`,
+		}},
+		{`contract aFlow {
+    func sign(int v) int {
+        if v > 0 {
+            return 1
+        } elif v < 0 {
+            return -1
+        }
+        return 0
+    }
+    func half(int w) int {
+        if w > 0 {
+            w = w / 2
+        } else {
+            return 0
+        }
+    }
+    int i = sign(2)
+    while i < 10 {
+        i += half(i)
+        break
+        i = 0
+    }
+    return str(i)
+    i = 1
+}`, []string{
+			`aFlow 16:5: Function half doesn't return a value on some paths`,
+			`aFlow 21:15: Unreachable code`,
+			`aFlow 24:11: Unreachable code`,
+		}},
+		{`contract aConst {
+    int i = $block
+    if 2 > 3 {
+        i = i / 0
+    }
+    while true {
+        i += 1
+    }
+    return str(i + $wallet)
+}`, []string{
+			`aConst 3:14: Condition is always false`,
+			`aConst 4:17: Possible division by zero`,
+			`aConst 6:11: Condition is always true`,
+			`aConst 9:20: Environment variable $wallet is read but unset`,
+		}},
+		{`contract aShadow {
+    func Hex(int i) str {
+        return str(i)
+    }
+    str Len = Hex(1)
+    return Len
+}`, []string{
+			`aShadow 4:5: Function Hex shadows the built-in function`,
+			`aShadow 5:5: Variable Len shadows the function with the same name`,
+		}},
+		{`contract aShadowOuter {
+    func sum(int a) int {
+        int a = 2
+        return a
+    }
+    int b = sum(1)
+    if b > 0 {
+        str b = "ok"
+    }
+    return str(b)
+}`, []string{
+			`aShadowOuter 2:14: Variable a is declared but not used`,
+			`aShadowOuter 3:9: Variable a shadows the variable of the outer block`,
+			`aShadowOuter 8:9: Variable b shadows the variable of the outer block`,
+			`aShadowOuter 8:9: Variable b is declared but not used`,
+		}},
+	} {
+		warnings, err := vm.Analyze(strings.Replace(item.Source, "\n", "\r\n", -1))
+		if err != nil {
+			t.Error(err)
+			continue
+		}
+		get := make([]string, len(warnings))
+		for i, warning := range warnings {
+			get[i] = warning.String()
+		}
+		if strings.Join(get, "\n") != strings.Join(item.Want, "\n") {
+			t.Errorf("get != want;\n%s\n!=\n%s", strings.Join(get, "\n"), strings.Join(item.Want, "\n"))
+		}
+	}
+}
+
+func TestAnalyzeRead(t *testing.T) {
+	vm := newVM(false)
+	for i, source := range []string{
+		"contract aReadLeaf read {\r\n    return `ok`\r\n}",
+		"contract aReadTo read {\r\n    return @aReadLeaf()\r\n}",
+	} {
+		if err := vm.LoadContract(source, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	source := "contract aRead read {\r\n    return @aReadTo()\r\n}"
+	if warnings, err := vm.Analyze(source); err != nil || len(warnings) != 0 {
+		t.Fatal(warnings, err)
+	}
+	// the read caller aReadTo doesn't allow to replace the contract with the mutable one
+	cnt, err := vm.Compile("contract aReadLeaf {\r\n    return testFunc(`w`, 1)\r\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = vm.Link(cnt, true); err == nil || err.Error() != `Contract aReadLeaf cannot be reloaded: `+
+		`aReadTo 2:23: Calling mutable function or contract from the read contract` {
+		t.Fatal(err)
+	}
+	if warnings, err := vm.Analyze(source); err != nil || len(warnings) != 0 {
+		t.Fatal(warnings, err)
+	}
+	// the host replaces the contract without recompiling of the dependents
+	vm.Contracts[vm.NameSpace[`aReadLeaf`]] = cnt
+	warnings, err := vm.Analyze(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(warnings) != 1 || warnings[0].String() !=
+		`aRead 2:21: Mutable contract @aReadLeaf can be called from the read contract through @aReadTo` {
+		t.Error(warnings)
+	}
+}
@@ -0,0 +1,160 @@
+package test
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const astSource = `contract astFloat {
+    data {
+        float rate = 0.5
+    }
+    func fee(money m) money {
+        return m * money(2)
+    }
+    if rate > 1.5 {
+        rate = 1.0
+    } else {
+        return str(fee(money(rate * 10)))
+    }
+}`
+
+// text returns the source between the positions
+func text(lines []string, begin, end parser.Position) string {
+	if begin.Line == end.Line {
+		return lines[begin.Line-1][begin.Column-1 : end.Column-1]
+	}
+	ret := []string{lines[begin.Line-1][begin.Column-1:]}
+	ret = append(ret, lines[begin.Line:end.Line-1]...)
+	return strings.Join(append(ret, lines[end.Line-1][:end.Column-1]), "\n")
+}
+
+func TestInspect(t *testing.T) {
+	lines := strings.Split(astSource, "\n")
+	root, err := parser.Parser(strings.Join(lines, "\r\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the check forbids float values
+	var floats []string
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if node != nil && node.Type == parser.TValue {
+			if _, ok := node.Value.(float64); ok {
+				floats = append(floats, fmt.Sprintf("%d:%d %s", node.Pos().Line, node.Pos().Column,
+					text(lines, node.Pos(), node.End())))
+			}
+		}
+		return true
+	})
+	if out := strings.Join(floats, `, `); out != `3:22 0.5, 8:15 1.5, 9:16 1.0` {
+		t.Errorf("wrong floats: %s", out)
+	}
+	var list []string
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if node == nil {
+			return false
+		}
+		switch node.Type {
+		case parser.TIf, parser.TReturn, parser.TCallFunc, parser.TBinary:
+			list = append(list, text(lines, node.Pos(), node.End()))
+		}
+		// the bodies of functions are skipped
+		return node.Type != parser.TFunc
+	})
+	want := []string{
+		"float rate = 0.5",
+		"if rate > 1.5 {\n        rate = 1.0\n    } else {\n        return str(fee(money(rate * 10)))\n    }",
+		"rate > 1.5",
+		"rate = 1.0",
+		"return str(fee(money(rate * 10)))",
+		"str(fee(money(rate * 10)))",
+		"fee(money(rate * 10))",
+		"money(rate * 10)",
+		"rate * 10",
+	}
+	if strings.Join(list, "|") != strings.Join(want, "|") {
+		t.Errorf("wrong nodes: %q", list)
+	}
+}
+
+type depthVisitor struct {
+	depth *int
+	max   *int
+}
+
+func (v depthVisitor) Visit(node *parser.Node) parser.Visitor {
+	if node == nil {
+		*v.depth--
+		return nil
+	}
+	if *v.depth++; *v.depth > *v.max {
+		*v.max = *v.depth
+	}
+	return v
+}
+
+func TestWalk(t *testing.T) {
+	contracts, err := loadTest(`default_test`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	before := func(a, b parser.Position) bool {
+		return a.Line < b.Line || (a.Line == b.Line && a.Column <= b.Column)
+	}
+	for _, cnt := range contracts {
+		root, err := parser.Parser(cnt.Source)
+		if err != nil {
+			continue
+		}
+		var depth, max int
+		parser.Walk(depthVisitor{&depth, &max}, root)
+		if depth != 0 || max < 2 {
+			t.Errorf("Line %d: wrong depth %d %d", cnt.Line, depth, max)
+		}
+		// the ranges of the children are inside the range of the parent
+		var check func(node *parser.Node)
+		check = func(node *parser.Node) {
+			if node.Pos().Line == 0 || !before(node.Pos(), node.End()) {
+				t.Errorf("Line %d: wrong range of %s %v-%v", cnt.Line, parser.GetNodeType(node.Type),
+					node.Pos(), node.End())
+			}
+			for _, child := range parser.Children(node) {
+				if !before(node.Pos(), child.Pos()) || !before(child.End(), node.End()) {
+					t.Errorf("Line %d: %s %v-%v is outside of %s %v-%v", cnt.Line,
+						parser.GetNodeType(child.Type), child.Pos(), child.End(),
+						parser.GetNodeType(node.Type), node.Pos(), node.End())
+				}
+				check(child)
+			}
+		}
+		check(root)
+	}
+}
+
+func TestASTJSON(t *testing.T) {
+	root, err := parser.Parser("contract astJSON {\r\n    // sum\r\n    int i = -2 + 3\r\n}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	out, err := json.Marshal(root)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{
+		`{"Type":"TContract","Pos":{"Line":1,"Column":1},"End":{"Line":4,"Column":2},"Value":{"Name":"astJSON"`,
+		`"Statements":[{"Type":"TBinary","Pos":{"Line":3,"Column":5},"End":{"Line":3,"Column":19},` +
+			`"Value":{"Oper":"=","Left":{"Type":"TVars"`,
+		`"Value":{"Type":1,"Name":"int"}`,
+		`"Value":{"Oper":"-","Operand":{"Type":"TValue","Pos":{"Line":3,"Column":14},` +
+			`"End":{"Line":3,"Column":15},"Result":"int","Value":2}}`,
+		`"Comments":[{"Text":"// sum","Line":2,"Column":5,"Trailing":false,"Inline":false}]`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("%s is missing in\n%s", want, out)
+		}
+	}
+}
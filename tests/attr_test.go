@@ -0,0 +1,84 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+func TestParamAttrs(t *testing.T) {
+	vm := newVM(false)
+	if err := vm.LoadContract("contract attrTest {\r\n    data {\r\n"+
+		"        money amount \"required,min=0\"\r\n        str memo \"optional,maxlen=4\"\r\n"+
+		"        str addr `regex=^[0-9a-f]{4}$`\r\n        int count\r\n    }\r\n"+
+		"    return str(IsSet(\"memo\")) + str(IsSet(\"count\"))\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	for _, item := range []struct {
+		Data paramsData
+		Want string
+	}{
+		{paramsData{`amount`: `10`}, `falsefalse`},
+		{paramsData{`amount`: `0`, `memo`: ``, `count`: `0`}, `truetrue`},
+		{paramsData{`memo`: `abc`}, `Parameter amount is required`},
+		{paramsData{`amount`: `-1.5`}, `Parameter amount must be greater than or equal to 0`},
+		{paramsData{`amount`: `1`, `memo`: `abcde`}, `Parameter memo must not be longer than 4`},
+		{paramsData{`amount`: `1`, `addr`: `0a1z`}, `Parameter addr doesn't match ^[0-9a-f]{4}$`},
+		{paramsData{`amount`: `1`, `addr`: `0a1f`}, `falsefalse`},
+	} {
+		result, _, err := vm.RunByName(`attrTest`, item.Data)
+		if err != nil {
+			result = err.Error()
+		}
+		if result != item.Want {
+			t.Errorf("wrong result %s != %s", result, item.Want)
+		}
+		// the host can check the parameters with ABI
+		err = vm.GetContract(`attrTest`).ABI().CheckParams(item.Data)
+		if strings.HasPrefix(item.Want, `Parameter`) {
+			if err == nil || err.Error() != item.Want {
+				t.Errorf("wrong ABI check %v", err)
+			}
+		} else if err != nil {
+			t.Errorf("wrong ABI check %v", err)
+		}
+	}
+	for _, item := range []struct {
+		Source string
+		Err    string
+	}{
+		{"contract attrWrong {\r\n    data {\r\n        str s \"min=1\"\r\n    }\r\n}",
+			`attrWrong 3:9: Attribute min is not supported for str type`},
+		{"contract attrWrong {\r\n    data {\r\n        int i \"required,optional\"\r\n    }\r\n}",
+			`attrWrong 3:9: Attributes required and optional cannot be used together`},
+		{"contract attrWrong {\r\n    data {\r\n        int i \"size=1\"\r\n    }\r\n}",
+			`attrWrong 3:9: Unknown attribute size`},
+		{"contract attrWrong {\r\n    data {\r\n        int i \"places=2\"\r\n    }\r\n}",
+			`attrWrong 3:9: Attribute places is not supported for int type`},
+		{"contract attrWrong {\r\n    data {\r\n        money m \"places=31\"\r\n    }\r\n}",
+			`attrWrong 3:9: Invalid value of attribute places`},
+		{"contract attrWrong {\r\n    return str(IsSet(\"a\"))\r\n}",
+			`attrWrong 2:25: Contract doesn't have a parameter`},
+		{"contract attrWrong {\r\n    return @attrTest(memo: `a`)\r\n}",
+			`attrWrong 2:31: Contract attrTest requires amount parameter`},
+	} {
+		if err := vm.LoadContract(item.Source, 0); err == nil || err.Error() != item.Err {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+	if err := vm.LoadContract("contract attrPlaces {\r\n    data {\r\n        money price \"places=2\"\r\n"+
+		"        money total\r\n    }\r\n    return str(price) + ` ` + str(total)\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	result, _, err := vm.RunByName(`attrPlaces`, paramsData{`price`: `12.3456`, `total`: `-7.5`})
+	if err != nil || result != `12.34 -8` {
+		t.Errorf("wrong places %v %s", err, result)
+	}
+	out, err := parser.FormatSource("contract attrFmt {\n    data {\n        str a b \"maxlen=2\"\n" +
+		"        str c `regex=^\\d+$`\n    }\n}\n")
+	if err != nil || out != "contract attrFmt {\n    data {\n        str a b \"maxlen=2\"\n"+
+		"        str c `regex=^\\d+$`\n    }\n}\n" {
+		t.Errorf("wrong format %v %s", err, out)
+	}
+}
@@ -0,0 +1,165 @@
+package test
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+const (
+	fmtSource = `/* the token */
+contract fmtToken   read {
+    // before data
+    data {
+      money  Amount   // the amount
+      str From To
+      // the end of data
+    }
+    // the fee
+    int fee=1+2*3 // trailing
+
+    /* before func */
+    func check(int x y, str s) bool {
+        if x>y&&(x<10||y>2) {
+            return x-(y-1)>0 // positive
+        } elif x==y {
+           // equal
+           return !(x>0)
+        } else {
+            return  -y>0
+            // the end of else
+        }
+    }
+    switch fee
+    case 1,2 {
+      fee = 3 // in case
+      fee ++
+    }
+    default {
+        fee+=4
+        fee|=1<<(fee&3)
+    }
+    map m = {"k": 1,"z": 2}
+    obj o = @{a: 1, "b c": [1, 2], d: {e: (fee+1)}}
+    str s = ?(fee>1, "a\"b", ` + "`c`" + `)
+    return str(check(fee,fee,s)) + s
+}
+// after contract`
+	fmtWant = `/* the token */
+contract fmtToken read {
+    // before data
+    data {
+        money Amount // the amount
+        str From To
+        // the end of data
+    }
+
+    // the fee
+    int fee = 1 + 2 * 3 // trailing
+
+    /* before func */
+    func check(int x y, str s) bool {
+        if x > y && (x < 10 || y > 2) {
+            return x - (y - 1) > 0 // positive
+        } elif x == y {
+            // equal
+            return !(x > 0)
+        } else {
+            return -y > 0
+            // the end of else
+        }
+    }
+    switch fee
+    case 1, 2 {
+        fee = 3 // in case
+        fee++
+    }
+    default {
+        fee += 4
+        fee |= 1 << (fee & 3)
+    }
+    map m = {"k": 1, "z": 2}
+    obj o = @{a: 1, "b c": [1, 2], d: {e: (fee + 1)}}
+    str s = ?(fee > 1, ` + "`a\"b`" + `, "c")
+    return str(check(fee, fee, s)) + s
+}
+// after contract
+`
+)
+
+func TestFormat(t *testing.T) {
+	out, err := parser.FormatSource(fmtSource)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if out != fmtWant {
+		t.Fatalf("wrong format:\n%s", out)
+	}
+	if again, err := parser.FormatSource(out); err != nil || again != out {
+		t.Errorf("format is not idempotent: %v\n%s", err, again)
+	}
+}
+
+func TestFormatFile(t *testing.T) {
+	contracts, err := loadTest(`default_test`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	vm := newVM(false)
+	var count int
+	for i := len(contracts) - 1; i >= 0; i-- {
+		source := contracts[i].Source
+		cnt, err := vm.Compile(source)
+		out, ferr := parser.FormatSource(source)
+		if ferr == nil {
+			if again, _ := parser.FormatSource(out); again != out {
+				t.Errorf("Line %d: format is not idempotent\n%s", contracts[i].Line, again)
+			}
+		}
+		if err == nil {
+			if ferr != nil {
+				t.Fatalf("Line %d: %v", contracts[i].Line, ferr)
+			}
+			formatted, err := vm.Compile(strings.Replace(out, "\n", "\r\n", -1))
+			if err != nil {
+				t.Errorf("Line %d: %v\n%s", contracts[i].Line, err, out)
+			} else if !reflect.DeepEqual(cnt.Code, formatted.Code) {
+				t.Errorf("Line %d: the bytecode is different\n%s", contracts[i].Line, out)
+			}
+			count++
+		}
+		vm.LoadContract(source, int64(i))
+	}
+	if count == 0 {
+		t.Error("no contracts have been compiled")
+	}
+}
+
+func TestFormatComments(t *testing.T) {
+	for _, item := range []struct {
+		Source, Want string
+	}{
+		{"contract fmtEmpty {\n    func f() { // c\n    }\n    if true {\n        f()\n    } else { /* c */ }\n" +
+			"    if false {\n    } else {}\n    try {\n    } catch e { // e\n    }\n}\n",
+			"contract fmtEmpty {\n    func f() {\n        // c\n    }\n    if true {\n        f()\n    } else {\n" +
+				"        /* c */\n    }\n    if false {\n    } else {\n    }\n    try {\n    } catch e {\n" +
+				"        // e\n    }\n}\n"},
+		{"contract fmtInline {\n    int a = 1 +  /* c */ 2\n    while a < /* max */ 5 {\n" +
+			"        a += Len(/* s */ \"ab\") /* end */\n    }\n    return str(/* a */ a)\n}\n",
+			"contract fmtInline {\n    int a = 1 + /* c */ 2\n    while a < /* max */ 5 {\n" +
+				"        a += Len(/* s */ \"ab\") /* end */\n    }\n    return str(/* a */ a)\n}\n"},
+	} {
+		out, err := parser.FormatSource(item.Source)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if out != item.Want {
+			t.Errorf("wrong format:\n%s", out)
+		}
+		if again, err := parser.FormatSource(out); err != nil || again != out {
+			t.Errorf("format is not idempotent: %v\n%s", err, again)
+		}
+	}
+}
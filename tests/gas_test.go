@@ -0,0 +1,186 @@
+package test
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestEstimateGas(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		vm := newVM(optimize)
+		for _, item := range []struct {
+			Source string
+			Want   string // the result of the estimation if it is unbounded
+		}{
+			{`contract gLine {
+    int i = 10
+    str s = str(i * 2) + Hex(bytes("ab"))
+    return s + readFunc("a", i)
+}`, ``},
+			{`contract gFor {
+    int sum
+    for i in 1..10 {
+        if i % 2 == 0 {
+            sum += i
+        } else {
+            for j in 0..3 {
+                sum += j
+            }
+        }
+    }
+    return str(sum)
+}`, ``},
+			{`contract gFunc {
+    func add(int a, int b) str {
+        if a > b {
+            return str(a + b)
+        }
+        return testFunc("x", b) + str(a)
+    }
+    return add(1, 2) + @gLine()
+}`, ``},
+			{`contract gWhile {
+    int i
+    for j in 0..5 {
+        while i < j {
+            i += 1
+        }
+    }
+    return str(i)
+}`, `unbounded: gWhile 4:9: loop without constant bounds`},
+			{`contract gMulti {
+    func pair(int x) (int, int) {
+        return x * 2, x
+    }
+    int sum j
+    for i in 1..3 {
+        i, j = pair(i)
+        sum += j
+    }
+    return str(sum)
+}`, `unbounded: gMulti 6:5: loop without constant bounds`},
+			{`contract gTry {
+    int sum
+    for i in 1..3 {
+        try {
+            i += 2
+        } catch e {
+            i = 0
+        }
+        sum += i
+    }
+    return str(sum)
+}`, `unbounded: gTry 3:5: loop without constant bounds`},
+			{`contract gCustom {
+    return fbmFunc(1.0, true, money(2))
+}`, `unbounded: gCustom: function fbmFunc has unknown gas`},
+			{`contract gSort {
+    arr.int a = {3, 1, 2}
+    Sort(a)
+    return str(Len(a))
+}`, `unbounded: gSort: function Sort gas depends on the size of the data`},
+			{`contract gRegexp {
+    return str(RegexpMatch("^a", "abc"))
+}`, `unbounded: gRegexp: function RegexpMatch gas depends on the size of the data`},
+		} {
+			if err := vm.LoadContract(strings.Replace(item.Source, "\n", "\r\n", -1), 0); err != nil {
+				t.Fatal(err)
+			}
+			cnt := vm.Contracts[len(vm.Contracts)-1]
+			est := vm.EstimateGas(cnt)
+			if len(item.Want) > 0 {
+				if est.String() != item.Want {
+					t.Errorf("%s: %s != %s", cnt.Name, est, item.Want)
+				}
+				continue
+			}
+			if est.Unbounded {
+				t.Errorf("%s: %s", cnt.Name, est)
+				continue
+			}
+			ret := run(vm, newData())
+			if ret.Err {
+				t.Errorf("%s: %s", cnt.Name, ret.Result)
+				continue
+			}
+			if est.Gas < ret.Gas {
+				t.Errorf("%s: estimated gas %d < %d", cnt.Name, est.Gas, ret.Gas)
+			}
+			t.Logf("%s optimize=%v: %d <= %d", cnt.Name, optimize, ret.Gas, est.Gas)
+		}
+	}
+}
+
+func TestEstimateGasFile(t *testing.T) {
+	vm := newVM(false)
+	contracts, err := loadTest(`default_test`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data := newData()
+	var bounded int
+	for i := int64(len(contracts)) - 1; i >= 0; i-- {
+		if err = vm.LoadContract(contracts[i].Source, i); err != nil {
+			continue
+		}
+		cnt := vm.Contracts[len(vm.Contracts)-1]
+		est := vm.EstimateGas(cnt)
+		if est.Unbounded {
+			continue
+		}
+		ret := run(vm, data)
+		if ret.Err {
+			continue
+		}
+		bounded++
+		if est.Gas < ret.Gas {
+			t.Errorf("Line %d: estimated gas %d < %d", contracts[i].Line, est.Gas, ret.Gas)
+		}
+	}
+	t.Logf("bounded contracts: %d of %d", bounded, len(contracts))
+}
+
+func TestSizedGas(t *testing.T) {
+	vm := newVM(false)
+	gas := func(source string) int64 {
+		if err := vm.LoadContract(strings.Replace(source, "\n", "\r\n", -1), 0); err != nil {
+			t.Fatal(err)
+		}
+		ret := run(vm, newData())
+		if ret.Err {
+			t.Fatal(ret.Result)
+		}
+		return ret.Gas
+	}
+	for _, item := range []struct {
+		Short, Long string
+	}{
+		{`contract sSort {
+    arr.int a = {3, 1}
+    Sort(a)
+}`, `contract lSort {
+    arr.int a = {3, 1, 2, 8, 5, 4, 9, 7}
+    Sort(a)
+}`},
+		{`contract sRegexp {
+    return str(RegexpMatch("a+b", "aab"))
+}`, `contract lRegexp {
+    return str(RegexpMatch("a+b", "cccccccccccccccaab"))
+}`},
+		{`contract sPattern {
+    return str(RegexpMatch("a", "aab"))
+}`, `contract lPattern {
+    return str(RegexpMatch("(a|b)+c?[0-9]{2,4}", "aab"))
+}`},
+		{`contract sRepeat {
+    return Repeat("ab", 2)
+}`, `contract lRepeat {
+    return Repeat("ab", 20)
+}`},
+	} {
+		short, long := gas(item.Short), gas(item.Long)
+		if short >= long {
+			t.Errorf("%s: gas %d >= %d", item.Short, short, long)
+		}
+	}
+}
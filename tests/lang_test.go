@@ -118,8 +118,8 @@ func (data myData) GetParam(name string) interface{} {
 	return data.Params[name]
 }
 
-func testFile(filename string) error {
-	vm := simvolio.NewVM(simvolio.VMSettings{
+func newVM(optimize bool) *simvolio.VM {
+	return simvolio.NewVM(simvolio.VMSettings{
 		GasLimit: 200000000,
 		Env: []simvolio.EnvItem{
 			{Name: `block`, Type: simvolio.Int},
@@ -127,20 +127,21 @@ func testFile(filename string) error {
 			{Name: `key`, Type: simvolio.Str},
 		},
 		Funcs: []simvolio.FuncItem{
-			{Func: readFunc, Name: `readFunc`, Read: true,
+			{Func: readFunc, Name: `readFunc`, Read: true, Gas: 50,
 				Params: []uint32{simvolio.Str, simvolio.Int}, Result: simvolio.Str},
-			{Func: testFunc, Name: `testFunc`, Params: []uint32{simvolio.Str, simvolio.Int}, Result: simvolio.Str},
+			{Func: testFunc, Name: `testFunc`, Params: []uint32{simvolio.Str, simvolio.Int}, Result: simvolio.Str,
+				Gas: 100},
 			{Func: fbmFunc, Name: `fbmFunc`, Params: []uint32{simvolio.Float, simvolio.Bool, simvolio.Money},
 				Result: simvolio.Str},
 			{Func: voidFunc, Name: `voidFunc`, Params: []uint32{simvolio.Str}},
 			{Func: objFunc, Name: `objFunc`, Params: []uint32{simvolio.Object}, Result: simvolio.Str},
 		},
+		Optimize: optimize,
 	})
-	contracts, err := loadTest(filename)
-	if err != nil {
-		return err
-	}
-	data := myData{
+}
+
+func newData() myData {
+	return myData{
 		Env: []interface{}{7, 1, `0122afcd34`},
 		Params: map[string]interface{}{
 			`pInt`:   "123",
@@ -153,6 +154,15 @@ func testFile(filename string) error {
 			`fFile`:  types.FileInit(`myfile.txt`, `text`, []byte{45, 47, 00, 32}),
 		},
 	}
+}
+
+func testFile(filename string) error {
+	vm := newVM(false)
+	contracts, err := loadTest(filename)
+	if err != nil {
+		return err
+	}
+	data := newData()
 	for i := int64(len(contracts)) - 1; i >= 0; i-- {
 		cnt := contracts[i]
 		if err = vm.LoadContract(cnt.Source, i); err != nil {
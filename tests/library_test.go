@@ -0,0 +1,104 @@
+package test
+
+import (
+	"os"
+	"testing"
+)
+
+func TestLibrary(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		`base.contract`: "library Base {\n    func twice(int x) int {\n        return x * 2\n    }\n}\n",
+		`utils.contract`: "library Utils {\n    import Base\n    func sum(int a b) int {\n" +
+			"        int s = a + b\n        return Base.twice(s)\n    }\n" +
+			"    func greet(str name) str {\n        return `Hi, ` + name\n    }\n" +
+			"    func div(int x y) str {\n        str out\n        try {\n            out = str(x / y)\n" +
+			"        } catch e {\n            out = e[`message`]\n        }\n        return out\n    }\n}\n",
+		`main.contract`: "contract libMain {\n    data {\n        int a\n    }\n    import Utils\n" +
+			"    int s = 5\n    return Utils.greet(str(Utils.sum(a, s)))\n}\n",
+		`try.contract`: "contract libTry {\n    import Utils\n    return Utils.div(6, 3) + ` ` + Utils.div(1, 0)\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	vm := newVM(false)
+	if _, err := vm.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	run := func(name string) string {
+		result, _, err := vm.RunByName(name, newData())
+		if err != nil {
+			return err.Error()
+		}
+		return result
+	}
+	if out := run(`libMain`); out != `Hi, 10` {
+		t.Errorf("wrong result %s", out)
+	}
+	if out := run(`libTry`); out != `2 dividing by zero` {
+		t.Errorf("wrong result %s", out)
+	}
+	if out := run(`Utils`); out != `Library Utils cannot be executed` {
+		t.Errorf("wrong result %s", out)
+	}
+	for _, item := range []struct {
+		Source string
+		Err    string
+	}{
+		{"contract libWrong {\r\n    import Utils\r\n    return Utils.sum(1, `2`)\r\n}",
+			`libWrong 3:28: Function Utils.sum(int, str) hasn't been defined`},
+		{"contract libWrong {\r\n    return Utils.greet(`x`)\r\n}",
+			`libWrong 2:27: Library Utils hasn't been imported`},
+		{"contract libWrong {\r\n    return @Utils()\r\n}",
+			`libWrong 2:19: Library Utils cannot be called as contract`},
+		{"contract libWrong {\r\n    import Unknown\r\n}",
+			`libWrong 2:12: Library Unknown hasn't been found`},
+		{"contract libWrong {\r\n    import libMain\r\n}",
+			`libWrong 2:12: Contract libMain is not a library`},
+		{"contract libWrong {\r\n    if true {\r\n        import Utils\r\n    }\r\n}",
+			`libWrong 3:16: Library must be imported at the top level of the contract`},
+		{"library libWrong {\r\n    int i\r\n}",
+			`libWrong 2:11: Library can contain only functions and imports`},
+	} {
+		if err := vm.LoadContract(item.Source, 0); err == nil || err.Error() != item.Err {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+	if err := vm.Unlink(`Base`); err == nil {
+		t.Errorf("Base has been unlinked")
+	}
+	// the functions of the library are compiled into the contracts so they are recompiled
+	if err := vm.ReloadContract("library Base {\r\n    func twice(int x) int {\r\n" +
+		"        return x * 3\r\n    }\r\n}"); err != nil {
+		t.Fatal(err)
+	}
+	if out := run(`libMain`); out != `Hi, 15` {
+		t.Errorf("wrong result %s", out)
+	}
+	err := vm.ReloadContract("library Base {\r\n    func twice(str x) str {\r\n" +
+		"        return x + x\r\n    }\r\n}")
+	if err == nil {
+		t.Errorf("incompatible library has been reloaded")
+	}
+	if out := run(`libMain`); out != `Hi, 15` {
+		t.Errorf("wrong result %s", out)
+	}
+}
+
+func TestLibrarySource(t *testing.T) {
+	// the library is compiled from its original source, so the positions are kept
+	dir := writeFiles(t, map[string]string{
+		`calc.contract`: "// the helpers\nlibrary Calc {\n\n    // multiplies\n    func mul(int x y) int {\n" +
+			"        return x * y\n    }\n}\n\ncontract libCalc {\n    import Calc\n" +
+			"    return str(Calc.mul(9223372036854775807, 2))\n}\n",
+	})
+	defer os.RemoveAll(dir)
+
+	vm := newVM(false)
+	vm.Settings.CheckedInt = true
+	if _, err := vm.LoadDir(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, _, err := vm.RunByName(`libCalc`, newData()); err == nil ||
+		err.Error() != `libCalc 6:20: integer overflow` {
+		t.Errorf("wrong error %v", err)
+	}
+}
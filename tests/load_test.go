@@ -0,0 +1,262 @@
+package test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+// writeFiles creates the temporary directory with the files
+func writeFiles(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir(``, `bvm`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for name, source := range files {
+		if err = ioutil.WriteFile(filepath.Join(dir, name), []byte(source), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestParseFile(t *testing.T) {
+	roots, err := parser.ParseFile(`two.contract`, "// first\r\ncontract one {\r\n    return `1`\r\n} // one\r\n"+
+		"\r\n// second\r\ncontract two {\r\n}\r\n")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(roots) != 2 || roots[1].Value.(*parser.NContract).Name != `two` || roots[1].Pos().Line != 7 {
+		t.Fatalf("wrong contracts %v", roots)
+	}
+	if len(roots[0].Comments) != 2 || roots[1].Comments[0].Text != `// second` {
+		t.Errorf("wrong comments %v %v", roots[0].Comments, roots[1].Comments)
+	}
+	_, err = parser.ParseFile(`err.contract`, "contract one {\r\n}\r\ncontract two {\r\n    int\r\n}")
+	if err == nil || err.Error() != `err.contract:4:9: syntax error: unexpected NEWLINE, expecting IDENT or DOT` {
+		t.Errorf("wrong error %v", err)
+	}
+	// Parser accepts only one contract
+	if _, err = parser.Parser("contract one {\r\n}\r\ncontract two {\r\n}"); err == nil {
+		t.Error("Parser must return the error")
+	}
+}
+
+func TestLoadDir(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		`a.contract`: "contract Caller {\r\n    return @Middle(s: `a`) + @Callee()\r\n}\r\n" +
+			"contract Other {\r\n    return `other`\r\n}",
+		"b.contract": "contract Middle {\n    data {\n        str s\n    }\n    return s + @Callee()\n}\n",
+		"c.contract": "contract Callee {\n    return `c`\n}\n",
+		"d.txt":      "it is not a contract",
+	})
+	defer os.RemoveAll(dir)
+
+	vm := newVM(false)
+	contracts, err := vm.LoadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var names []string
+	for _, cnt := range contracts {
+		names = append(names, cnt.Name)
+	}
+	if strings.Join(names, ` `) != `Caller Other Middle Callee` || len(vm.Contracts) != 4 {
+		t.Errorf("wrong contracts %v", names)
+	}
+	if vm.Contracts[0].Name != `Callee` || contracts[0].ID != 2 {
+		t.Errorf("wrong order of compilation %s %d", vm.Contracts[0].Name, contracts[0].ID)
+	}
+	if result, _, err := vm.Run(contracts[0], newData()); err != nil || result != `acc` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+}
+
+func TestLoadErrors(t *testing.T) {
+	dir := writeFiles(t, map[string]string{
+		`cycle.contract`: "contract A {\r\n    return @B()\r\n}\r\ncontract B {\r\n    return @C()\r\n}",
+		`c.contract`:     "contract C {\r\n    return @B()\r\n}",
+		`dup.contract`:   "contract C {\r\n}",
+		`err.contract`:   "contract ok {\r\n    return `ok`\r\n}\r\ncontract bad {\r\n    return x\r\n}",
+		`call.contract`:  "contract caller {\r\n    return @ok()\r\n}",
+	})
+	defer os.RemoveAll(dir)
+
+	file := func(name string) string {
+		return filepath.Join(dir, name)
+	}
+	for _, item := range []struct {
+		files []string
+		err   string
+	}{
+		{[]string{`cycle.contract`, `c.contract`},
+			`Contracts have cyclic dependency B (%cycle.contract) -> C (%c.contract) -> B`},
+		{[]string{`c.contract`, `dup.contract`},
+			`%dup.contract: Contract C has already been defined in %c.contract`},
+		{[]string{`call.contract`, `err.contract`}, `%err.contract:5:12: bad: Variable x hasn't been defined`},
+	} {
+		vm := newVM(false)
+		var files []string
+		for _, name := range item.files {
+			files = append(files, file(name))
+		}
+		_, err := vm.LoadFiles(files...)
+		if want := strings.Replace(item.err, `%`, dir+string(filepath.Separator), -1); err == nil ||
+			err.Error() != want {
+			t.Errorf("wrong error %v; expecting %s", err, want)
+		}
+		// nothing is linked
+		if len(vm.Contracts) != 0 || vm.GetContract(`ok`) != nil {
+			t.Errorf("%v: the contracts have been linked", item.files)
+		}
+	}
+	vm := newVM(false)
+	if _, err := vm.LoadFiles(file(`dup.contract`)); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := vm.LoadFiles(file(`c.contract`)); err == nil ||
+		err.Error() != file(`c.contract`)+`: Contract C has already been defined` {
+		t.Errorf("wrong error %v", err)
+	}
+}
+
+func TestReload(t *testing.T) {
+	vm := newVM(false)
+	for i, source := range []string{
+		"contract rCallee {\r\n    data {\r\n        int a\r\n        str s\r\n    }\r\n    return s + str(a)\r\n}",
+		"contract rCaller {\r\n    return @rCallee(s: `x`, a: 1)\r\n}",
+		"contract rNoParams {\r\n    return @rCallee() + @rCaller()\r\n}",
+	} {
+		if err := vm.LoadContract(source, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	run := func(name string) string {
+		result, _, err := vm.RunByName(name, newData())
+		if err != nil {
+			return err.Error()
+		}
+		return result
+	}
+	if deps := vm.Dependents(`rCallee`); len(deps) != 2 || deps[0].Name != `rCaller` {
+		t.Errorf("wrong dependents %v", deps)
+	}
+	caller := vm.GetContract(`rCaller`)
+	// the parameters are the same, the callers are not recompiled
+	if err := vm.ReloadContract("contract rCallee {\r\n    data {\r\n        int a\r\n        str s\r\n" +
+		"    }\r\n    return s + `=` + str(a)\r\n}"); err != nil {
+		t.Fatal(err)
+	}
+	if vm.GetContract(`rCaller`) != caller || run(`rCaller`) != `x=1` {
+		t.Errorf("wrong reload %s", run(`rCaller`))
+	}
+	// the indexes of the parameters are changed
+	if err := vm.ReloadContract("contract rCallee {\r\n    data {\r\n        str s\r\n        int a\r\n" +
+		"    }\r\n    return s + `-` + str(a)\r\n}"); err != nil {
+		t.Fatal(err)
+	}
+	if vm.GetContract(`rCaller`) == caller || vm.GetContract(`rCaller`).ID != 1 || run(`rNoParams`) != `-0x-1` {
+		t.Errorf("wrong reload %s", run(`rNoParams`))
+	}
+	// rCaller cannot be compiled with the new type of the parameter
+	err := vm.ReloadContract("contract rCallee {\r\n    data {\r\n        str s\r\n        str a\r\n" +
+		"    }\r\n    return s + a\r\n}")
+	if err == nil || err.Error() != `Contract rCallee cannot be reloaded: rCaller 2:33: Unexpected type of the `+
+		`parameter; expecting str` {
+		t.Errorf("wrong error %v", err)
+	}
+	if run(`rCaller`) != `x-1` {
+		t.Errorf("the contract has been changed %s", run(`rCaller`))
+	}
+	// the read caller cannot call the contract which is not read anymore
+	for i, source := range []string{
+		"contract rReadCallee read {\r\n    return `read`\r\n}",
+		"contract rReader read {\r\n    return @rReadCallee()\r\n}",
+	} {
+		if err := vm.LoadContract(source, int64(i+3)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	err = vm.ReloadContract("contract rReadCallee {\r\n    return `mutable`\r\n}")
+	if err == nil || err.Error() != `Contract rReadCallee cannot be reloaded: rReader 2:25: Calling mutable `+
+		`function or contract from the read contract` {
+		t.Errorf("wrong error %v", err)
+	}
+	if run(`rReader`) != `read` {
+		t.Errorf("the contract has been changed %s", run(`rReader`))
+	}
+}
+
+func TestVersions(t *testing.T) {
+	vm := newVM(false)
+	run := func(name string) string {
+		result, _, err := vm.RunByName(name, newData())
+		if err != nil {
+			return err.Error()
+		}
+		return result
+	}
+	if err := vm.LoadContract("contract vToken {\r\n    return `v1`\r\n}", 10); err != nil {
+		t.Fatal(err)
+	}
+	if err := vm.ReloadContract("contract vToken {\r\n    return `v2`\r\n}"); err != nil {
+		t.Fatal(err)
+	}
+	// the failed compilation doesn't leave the pinned version
+	count := len(vm.Contracts)
+	if _, err := vm.Compile("contract vBad {\r\n    return @vToken.v1() + 1\r\n}"); err == nil ||
+		vm.GetContract(`vToken.v1`) != nil || len(vm.Contracts) != count {
+		t.Errorf("the version has been pinned %v", err)
+	}
+	for i, source := range []string{
+		"contract vUser {\r\n    return @vToken() + @vToken.v1()\r\n}",
+		"contract vPin {\r\n    return @vToken.v2()\r\n}",
+	} {
+		if err := vm.LoadContract(source, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := vm.ReloadContract("contract vToken {\r\n    return `v3`\r\n}"); err != nil {
+		t.Fatal(err)
+	}
+	if cnt := vm.GetContract(`vToken`); cnt.Version != 3 || cnt.ID != 10 || len(vm.Versions(`vToken`)) != 3 {
+		t.Errorf("wrong version %d %d", cnt.Version, cnt.ID)
+	}
+	if run(`vUser`) != `v3v1` || run(`vPin`) != `v2` {
+		t.Errorf("wrong results %s %s", run(`vUser`), run(`vPin`))
+	}
+	if err := vm.Rollback(`vToken`, 1); err != nil {
+		t.Fatal(err)
+	}
+	if run(`vUser`) != `v1v1` || run(`vPin`) != `v2` || vm.GetContract(`vToken`).Version != 1 {
+		t.Errorf("wrong rollback %s %s", run(`vUser`), run(`vPin`))
+	}
+	if err := vm.Rollback(`vToken`, 4); err == nil || err.Error() != `Contract vToken doesn't have version 4` {
+		t.Errorf("wrong error %v", err)
+	}
+	err := vm.Unlink(`vToken`)
+	if err == nil || err.Error() != `Contract vToken cannot be unlinked, it is called by vUser, vPin` {
+		t.Errorf("wrong error %v", err)
+	}
+	for _, name := range []string{`vUser`, `vPin`, `vToken`} {
+		if err = vm.Unlink(name); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if vm.GetContract(`vToken`) != nil || vm.GetContract(`vToken.v2`) != nil || len(vm.Versions(`vToken`)) != 0 {
+		t.Error("the contract has not been unlinked")
+	}
+	if _, err = vm.Compile("contract vNew {\r\n    return @vToken.v1()\r\n}"); err == nil ||
+		!strings.HasSuffix(err.Error(), `Contract vToken.v1 hasn't been found`) {
+		t.Errorf("wrong error %v", err)
+	}
+	// the name can be used again
+	if err = vm.LoadContract("contract vToken {\r\n    return `new`\r\n}", 11); err != nil ||
+		vm.GetContract(`vToken`).Version != 1 || run(`vToken`) != `new` {
+		t.Errorf("wrong contract %v", err)
+	}
+}
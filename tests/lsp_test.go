@@ -0,0 +1,279 @@
+package test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/lsp"
+)
+
+const (
+	lspToken = `contract lspToken {
+    data {
+        money amount
+        str comment
+    }
+    return str(amount) + comment
+}`
+	lspWallet = `contract lspWallet {
+    func fee(money m) money {
+        return m / money(100)
+    }
+    money total = fee(money(10))
+    total += money($block)
+    return @lspToken(amount: total, comment: str(total))
+}`
+	lspBroken = "contract lspBroken {\n    int i = \"a\"\n    return $b\n}"
+)
+
+type lspMessage struct {
+	ID     int             `json:"id"`
+	Method string          `json:"method"`
+	Params json.RawMessage `json:"params"`
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code int `json:"code"`
+	} `json:"error"`
+}
+
+func lspSession(t *testing.T, requests []string) []lspMessage {
+	var in, out bytes.Buffer
+	for _, req := range requests {
+		fmt.Fprintf(&in, "Content-Length: %d\r\n\r\n%s", len(req), req)
+	}
+	if err := lsp.NewServer(newVM(false)).Serve(&in, &out); err != nil {
+		t.Fatal(err)
+	}
+	var ret []lspMessage
+	reader := bufio.NewReader(&out)
+	for {
+		header, err := reader.ReadString('\n')
+		if err == io.EOF {
+			break
+		}
+		length, err := strconv.Atoi(strings.TrimSpace(strings.TrimPrefix(header, `Content-Length:`)))
+		if err != nil {
+			t.Fatal(header)
+		}
+		reader.ReadString('\n')
+		body := make([]byte, length)
+		if _, err = io.ReadFull(reader, body); err != nil {
+			t.Fatal(err)
+		}
+		var msg lspMessage
+		if err = json.Unmarshal(body, &msg); err != nil {
+			t.Fatal(err)
+		}
+		ret = append(ret, msg)
+	}
+	return ret
+}
+
+func didOpen(uri, text string) string {
+	params, _ := json.Marshal(map[string]interface{}{
+		`textDocument`: map[string]string{`uri`: uri, `languageId`: `bvm`, `text`: text},
+	})
+	return fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didOpen","params":%s}`, params)
+}
+
+func posRequest(id int, method, uri string, line, character int) string {
+	return fmt.Sprintf(`{"jsonrpc":"2.0","id":%d,"method":"textDocument/%s","params":{"textDocument":`+
+		`{"uri":"%s"},"position":{"line":%d,"character":%d}}}`, id, method, uri, line, character)
+}
+
+func TestLSP(t *testing.T) {
+	msgs := lspSession(t, []string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		`{"jsonrpc":"2.0","method":"initialized","params":{}}`,
+		didOpen(`file:///wallet`, lspWallet),
+		didOpen(`file:///token`, lspToken),
+		didOpen(`file:///broken`, lspBroken),
+		posRequest(2, `hover`, `file:///wallet`, 4, 11),
+		posRequest(3, `hover`, `file:///wallet`, 4, 20),
+		posRequest(4, `hover`, `file:///wallet`, 5, 22),
+		posRequest(5, `hover`, `file:///wallet`, 6, 13),
+		posRequest(6, `hover`, `file:///wallet`, 4, 23),
+		posRequest(7, `definition`, `file:///wallet`, 6, 50),
+		posRequest(8, `definition`, `file:///wallet`, 4, 19),
+		posRequest(9, `definition`, `file:///wallet`, 6, 13),
+		posRequest(10, `completion`, `file:///wallet`, 6, 21),
+		posRequest(11, `completion`, `file:///wallet`, 5, 20),
+		`{"jsonrpc":"2.0","id":12,"method":"unknown"}`,
+		`{"jsonrpc":"2.0","id":13,"method":"shutdown"}`,
+		`{"jsonrpc":"2.0","method":"exit"}`,
+		posRequest(14, `hover`, `file:///wallet`, 4, 11),
+	})
+	get := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		switch {
+		case msg.Method == `textDocument/publishDiagnostics`:
+			var params struct {
+				URI         string
+				Diagnostics []lsp.Diagnostic
+			}
+			json.Unmarshal(msg.Params, &params)
+			list := []string{params.URI}
+			for _, diag := range params.Diagnostics {
+				list = append(list, fmt.Sprintf(`%s %d %s`, lspRange(diag.Range), diag.Severity, diag.Message))
+			}
+			get = append(get, strings.Join(list, ` `))
+		case msg.Error != nil:
+			get = append(get, fmt.Sprintf(`%d: error %d`, msg.ID, msg.Error.Code))
+		case msg.ID == 1:
+			get = append(get, fmt.Sprintf(`%d: %v`, msg.ID, strings.Contains(string(msg.Result),
+				`"hoverProvider":true`)))
+		case msg.ID >= 2 && msg.ID <= 6:
+			var hover lsp.Hover
+			json.Unmarshal(msg.Result, &hover)
+			get = append(get, fmt.Sprintf(`%d: %s %q`, msg.ID, lspRange(*hover.Range),
+				hover.Contents.Value))
+		case msg.ID >= 7 && msg.ID <= 9:
+			var loc lsp.Location
+			json.Unmarshal(msg.Result, &loc)
+			get = append(get, fmt.Sprintf(`%d: %s %s`, msg.ID, loc.URI, lspRange(loc.Range)))
+		case msg.ID == 10 || msg.ID == 11:
+			var items []lsp.CompletionItem
+			json.Unmarshal(msg.Result, &items)
+			labels := make([]string, 0, len(items))
+			for _, item := range items {
+				labels = append(labels, item.Label)
+			}
+			get = append(get, fmt.Sprintf(`%d: %s`, msg.ID, strings.Join(labels, ` `)))
+		default:
+			get = append(get, fmt.Sprintf(`%d: %s`, msg.ID, msg.Result))
+		}
+	}
+	want := []string{
+		`1: true`,
+		`file:///wallet 6:55-6:56 1 Contract lspToken hasn't been found`,
+		`file:///token`,
+		`file:///wallet`,
+		`file:///broken 1:12-1:15 1 Operator int=str has not been found`,
+		`2: 4:10-4:15 "money total"`,
+		`3: 4:18-4:21 "func fee(money m) money"`,
+		`4: 5:19-5:25 "$block int"`,
+		`5: 6:11-6:20 "contract lspToken\ndata {\n    money amount\n    str comment\n}"`,
+		`6: 4:22-4:27 "money(int) money\nmoney(float) money\nmoney(str) money\nmoney(float, int) money\nmoney(str, int) money"`,
+		`7: file:///wallet 4:10-4:15`,
+		`8: file:///wallet 1:9-1:12`,
+		`9: file:///token 0:9-0:17`,
+		`10: amount comment`,
+		`11: block ecosystem key`,
+		`12: error -32601`,
+		`13: null`,
+	}
+	if strings.Join(get, "\n") != strings.Join(want, "\n") {
+		t.Errorf("get != want;\n%s\n!=\n%s", strings.Join(get, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func TestLSPUnicode(t *testing.T) {
+	const (
+		uniBroken = "contract lspUniBroken {\n    str s = \"мир\"\n    int i = \"Привет\" + 1\n}"
+		uniOk     = "contract lspUni {\n    str s = \"мир\"\n    str t = \"Ёжик, \" + s\n    return t\n}"
+	)
+	for _, item := range []struct {
+		Capabilities string
+		Character    int
+		Want         []string
+	}{
+		{`{}`, 23, []string{`1: "positionEncoding":"utf-16"`, `file:///broken 2:23-2:24`, `file:///uni`,
+			`file:///broken 2:23-2:24`, `2: 2:23-2:24 "str s"`, `3: 1:8-1:9`}},
+		{`{"general":{"positionEncodings":["utf-8","utf-16"]}}`, 27, []string{
+			`1: "positionEncoding":"utf-8"`, `file:///broken 2:29-2:30`, `file:///uni`,
+			`file:///broken 2:29-2:30`, `2: 2:27-2:28 "str s"`, `3: 1:8-1:9`}},
+	} {
+		msgs := lspSession(t, []string{
+			`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{"capabilities":` + item.Capabilities + `}}`,
+			didOpen(`file:///broken`, uniBroken),
+			didOpen(`file:///uni`, uniOk),
+			posRequest(2, `hover`, `file:///uni`, 2, item.Character),
+			posRequest(3, `definition`, `file:///uni`, 2, item.Character),
+		})
+		get := make([]string, 0, len(msgs))
+		for _, msg := range msgs {
+			switch {
+			case msg.Method == `textDocument/publishDiagnostics`:
+				var params struct {
+					URI         string
+					Diagnostics []lsp.Diagnostic
+				}
+				json.Unmarshal(msg.Params, &params)
+				list := []string{params.URI}
+				for _, diag := range params.Diagnostics {
+					list = append(list, lspRange(diag.Range))
+				}
+				get = append(get, strings.Join(list, ` `))
+			case msg.ID == 1:
+				off := strings.Index(string(msg.Result), `"positionEncoding"`)
+				get = append(get, fmt.Sprintf(`%d: %s`, msg.ID, strings.SplitN(string(msg.Result[off:]), `,`, 2)[0]))
+			case msg.ID == 2:
+				var hover lsp.Hover
+				json.Unmarshal(msg.Result, &hover)
+				get = append(get, fmt.Sprintf(`%d: %s %q`, msg.ID, lspRange(*hover.Range), hover.Contents.Value))
+			case msg.ID == 3:
+				var loc lsp.Location
+				json.Unmarshal(msg.Result, &loc)
+				get = append(get, fmt.Sprintf(`%d: %s`, msg.ID, lspRange(loc.Range)))
+			}
+		}
+		if strings.Join(get, "\n") != strings.Join(item.Want, "\n") {
+			t.Errorf("get != want;\n%s\n!=\n%s", strings.Join(get, "\n"), strings.Join(item.Want, "\n"))
+		}
+	}
+}
+
+func TestLSPLink(t *testing.T) {
+	change := func(uri, text string) string {
+		params, _ := json.Marshal(map[string]interface{}{
+			`textDocument`:   map[string]interface{}{`uri`: uri, `version`: 2},
+			`contentChanges`: []map[string]string{{`text`: text}},
+		})
+		return fmt.Sprintf(`{"jsonrpc":"2.0","method":"textDocument/didChange","params":%s}`, params)
+	}
+	msgs := lspSession(t, []string{
+		`{"jsonrpc":"2.0","id":1,"method":"initialize","params":{}}`,
+		didOpen(`file:///token`, lspToken),
+		didOpen(`file:///wallet`, lspWallet),
+		// lspWallet cannot be recompiled so the changed lspToken is not linked
+		change(`file:///token`, "contract lspToken {\n    data {\n        money amount\n    }\n"+
+			"    return str(amount)\n}"),
+		change(`file:///wallet`, lspWallet),
+	})
+	var get []string
+	for _, msg := range msgs {
+		if msg.Method != `textDocument/publishDiagnostics` {
+			continue
+		}
+		var params struct {
+			URI         string
+			Diagnostics []lsp.Diagnostic
+		}
+		json.Unmarshal(msg.Params, &params)
+		list := []string{params.URI}
+		for _, diag := range params.Diagnostics {
+			list = append(list, fmt.Sprintf(`%s %d %s`, lspRange(diag.Range), diag.Severity, diag.Message))
+		}
+		get = append(get, strings.Join(list, ` `))
+	}
+	want := []string{
+		`file:///token`,
+		`file:///wallet`,
+		"file:///token 0:9-0:17 1 Contract lspToken cannot be reloaded: " +
+			"file:///wallet:7:56: lspWallet: Contract doesn't have comment parameter",
+		`file:///wallet`,
+	}
+	if strings.Join(get, "\n") != strings.Join(want, "\n") {
+		t.Errorf("get != want;\n%s\n!=\n%s", strings.Join(get, "\n"), strings.Join(want, "\n"))
+	}
+}
+
+func lspRange(r lsp.Range) string {
+	return fmt.Sprintf(`%d:%d-%d:%d`, r.Start.Line, r.Start.Character, r.End.Line, r.End.Character)
+}
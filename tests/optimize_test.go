@@ -0,0 +1,121 @@
+package test
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm"
+)
+
+type runResult struct {
+	Result string
+	Gas    int64
+	Err    bool
+	Panic  bool
+}
+
+func runFile(vm *simvolio.VM, filename string) ([]runResult, error) {
+	contracts, err := loadTest(filename)
+	if err != nil {
+		return nil, err
+	}
+	data := newData()
+	ret := make([]runResult, len(contracts))
+	for i := int64(len(contracts)) - 1; i >= 0; i-- {
+		if err = vm.LoadContract(contracts[i].Source, i); err != nil {
+			ret[i].Result = err.Error()
+			continue
+		}
+		ret[i] = run(vm, data)
+	}
+	return ret, nil
+}
+
+func run(vm *simvolio.VM, data myData) (ret runResult) {
+	defer func() {
+		if r := recover(); r != nil {
+			ret = runResult{Result: fmt.Sprint(r), Err: true, Panic: true}
+		}
+	}()
+	result, gas, err := vm.Run(vm.Contracts[len(vm.Contracts)-1], data)
+	if err != nil {
+		return runResult{Result: err.Error(), Gas: gas, Err: true}
+	}
+	return runResult{Result: result, Gas: gas}
+}
+
+func TestOptimize(t *testing.T) {
+	plain, err := runFile(newVM(false), `default_test`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	optimized, err := runFile(newVM(true), `default_test`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	contracts, _ := loadTest(`default_test`)
+	var gasPlain, gasOptimized int64
+	for i := range plain {
+		// the errors and the panics must have the same messages and positions
+		if plain[i].Result != optimized[i].Result || plain[i].Err != optimized[i].Err ||
+			plain[i].Panic != optimized[i].Panic {
+			t.Errorf("Line %d: %s != %s", contracts[i].Line, optimized[i].Result, plain[i].Result)
+			continue
+		}
+		if plain[i].Err {
+			continue
+		}
+		if optimized[i].Gas > plain[i].Gas {
+			t.Errorf("Line %d: gas %d > %d", contracts[i].Line, optimized[i].Gas, plain[i].Gas)
+		}
+		gasPlain += plain[i].Gas
+		gasOptimized += optimized[i].Gas
+	}
+	t.Logf("gas %d => %d", gasPlain, gasOptimized)
+}
+
+const benchSource = `contract Bench {
+    int sum
+    arr.int a
+    for i in 1..1000 {
+        if 2*3 > 5 {
+            sum += i % 7
+        }
+        a += i
+    }
+    for item in a {
+        sum = sum + item
+        if sum > 100000000 {
+            break
+            sum = 0
+        }
+    }
+    return sum
+}`
+
+func benchmarkRun(b *testing.B, optimize bool) {
+	vm := newVM(optimize)
+	if err := vm.LoadContract(strings.Replace(benchSource, "\n", "\r\n", -1), 0); err != nil {
+		b.Fatal(err)
+	}
+	data := newData()
+	cnt := vm.Contracts[len(vm.Contracts)-1]
+	var gas int64
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		var err error
+		if _, gas, err = vm.Run(cnt, data); err != nil {
+			b.Fatal(err)
+		}
+	}
+	b.ReportMetric(float64(gas), "gas/op")
+}
+
+func BenchmarkOptimize(b *testing.B) {
+	for _, optimize := range []bool{false, true} {
+		b.Run(fmt.Sprintf("optimize=%v", optimize), func(b *testing.B) {
+			benchmarkRun(b, optimize)
+		})
+	}
+}
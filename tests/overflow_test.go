@@ -0,0 +1,72 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/shelmesky/bvm/runtime"
+)
+
+func TestOverflow(t *testing.T) {
+	for _, optimize := range []bool{false, true} {
+		vm := newVM(optimize)
+		vm.Settings.CheckedInt = true
+		for i, item := range []struct {
+			Source string
+			Result string
+		}{
+			{"contract ovfAdd {\r\n    int a = 9223372036854775807\r\n    str s = `max`\r\n" +
+				"    return s + str(a + 1)\r\n}", `ovfAdd 4:25: integer overflow`},
+			{"contract ovfSub {\r\n    int a = -9223372036854775807\r\n    a -= 2\r\n    return str(a)\r\n}",
+				`ovfSub 3:12: integer overflow`},
+			{"contract ovfMul {\r\n    int a = 4611686018427387904\r\n    if a > 0 && a * 2 > 0 {\r\n" +
+				"        return `yes`\r\n    }\r\n    return `no`\r\n}", `ovfMul 3:21: integer overflow`},
+			{"contract ovfNeg {\r\n    int a = -9223372036854775807\r\n    a -= 1\r\n    return str(-a)\r\n}",
+				`ovfNeg 4:17: integer overflow`},
+			{"contract ovfDiv {\r\n    int a = -9223372036854775807\r\n    a -= 1\r\n    a /= -1\r\n    return str(a)\r\n}",
+				`ovfDiv 4:13: integer overflow`},
+			{"contract ovfInc {\r\n    int a = 9223372036854775806\r\n    a += 1\r\n    a += 1\r\n    return str(a)\r\n}",
+				`ovfInc 4:12: integer overflow`},
+			{"contract ovfTry {\r\n    int a = 9223372036854775807\r\n    str out\r\n    try {\r\n" +
+				"        a *= 3\r\n    } catch e {\r\n        out = e[`message`]\r\n    }\r\n    return out + ` ` + str(a)\r\n}",
+				`ovfTry 5:16: integer overflow 9223372036854775807`},
+			{"contract ovfShl {\r\n    int a = 3\r\n    int n = 62\r\n    return str(a << n)\r\n}",
+				`ovfShl 4:21: integer overflow`},
+			{"contract ovfConstAdd {\r\n    int a = 9223372036854775807 + 1\r\n    return str(a)\r\n}",
+				`ovfConstAdd 2:37: integer overflow`},
+			{"contract ovfConstSub {\r\n    return str(-9223372036854775807 - 2)\r\n}",
+				`ovfConstSub 2:40: integer overflow`},
+			{"contract ovfConstMul {\r\n    return str(4611686018427387904 * 2)\r\n}",
+				`ovfConstMul 2:38: integer overflow`},
+			{"contract ovfConstDiv {\r\n    return str((-9223372036854775807 - 1) / -1)\r\n}",
+				`ovfConstDiv 2:46: integer overflow`},
+			{"contract ovfConstNeg {\r\n    return str(-(-9223372036854775807 - 1))\r\n}",
+				`ovfConstNeg 2:42: integer overflow`},
+			{"contract ovfConstShl {\r\n    return str(3 << 62)\r\n}",
+				`ovfConstShl 2:21: integer overflow`},
+			{"contract ovfNone {\r\n    int a = 9223372036854775806\r\n    a += 1\r\n    return str(a * -1 - 1)\r\n}",
+				`-9223372036854775808`},
+		} {
+			if err := vm.LoadContract(item.Source, 0); err != nil {
+				t.Fatal(err)
+			}
+			result, _, err := vm.Run(vm.Contracts[len(vm.Contracts)-1], newData())
+			if err != nil {
+				if _, ok := err.(*runtime.OverflowError); !ok {
+					t.Errorf("%d wrong error type %T", i, err)
+				}
+				result = err.Error()
+			}
+			if result != item.Result {
+				t.Errorf("%d %v wrong result %s", i, optimize, result)
+			}
+		}
+	}
+	vm := newVM(false)
+	if err := vm.LoadContract("contract ovfWrap {\r\n    int a = 9223372036854775807\r\n"+
+		"    a += 1\r\n    return str(a)\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	if result, _, err := vm.RunByName(`ovfWrap`, newData()); err != nil || result != `-9223372036854775808` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+}
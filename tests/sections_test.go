@@ -0,0 +1,60 @@
+package test
+
+import (
+	"testing"
+)
+
+func TestSections(t *testing.T) {
+	vm := newVM(false)
+	if err := vm.LoadContract("contract secTest {\r\n    data {\r\n        int a\r\n    }\r\n"+
+		"    func check(int x) str {\r\n        return readFunc(`x`, 10 / x)\r\n    }\r\n"+
+		"    conditions {\r\n        check(a)\r\n    }\r\n"+
+		"    action {\r\n        return testFunc(`a`, 10 / (a - 1))\r\n    }\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	cnt := vm.GetContract(`secTest`)
+	for _, item := range []struct {
+		A        string
+		Validate string
+		Run      string
+	}{
+		{`2`, ``, `a10`},
+		{`1`, ``, `dividing by zero`},
+		{`0`, `dividing by zero`, `dividing by zero`},
+	} {
+		data := paramsData{`a`: item.A}
+		var validate string
+		if _, err := vm.Validate(cnt, data); err != nil {
+			validate = err.Error()
+		}
+		result, _, err := vm.Run(cnt, data)
+		if err != nil {
+			result = err.Error()
+		}
+		if validate != item.Validate || result != item.Run {
+			t.Errorf("wrong result %s %s", validate, result)
+		}
+	}
+	for _, item := range []struct {
+		Source string
+		Err    string
+	}{
+		{"contract secWrong {\r\n    conditions {\r\n        testFunc(`a`, 1)\r\n    }\r\n}",
+			`secWrong 3:24: Calling mutable function or contract from the read contract`},
+		{"contract secWrong {\r\n    func mut() {\r\n        testFunc(`a`, 1)\r\n    }\r\n" +
+			"    conditions {\r\n        mut()\r\n    }\r\n    action {\r\n        mut()\r\n    }\r\n}",
+			`secWrong 6:13: Calling mutable function or contract from the read contract`},
+		{"contract secWrong {\r\n    action {\r\n    }\r\n    conditions {\r\n    }\r\n}",
+			`secWrong 5:5: conditions must be defined before action`},
+		{"contract secWrong {\r\n    action {\r\n    }\r\n    action {\r\n    }\r\n}",
+			`secWrong 5:5: action has already been defined`},
+		{"contract secWrong {\r\n    if true {\r\n        conditions {\r\n        }\r\n    }\r\n}",
+			`secWrong 4:9: conditions must be at the top level of the contract`},
+		{"contract secWrong {\r\n    conditions {\r\n        return\r\n    }\r\n}",
+			`secWrong 3:16: return cannot be used in conditions`},
+	} {
+		if err := vm.LoadContract(item.Source, 0); err == nil || err.Error() != item.Err {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+}
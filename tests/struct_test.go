@@ -0,0 +1,98 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+func TestStruct(t *testing.T) {
+	vm := newVM(false)
+	source := "contract structMain {\r\n    data {\r\n        Order order\r\n        int count\r\n    }\r\n" +
+		"    type Item struct {\r\n        str name\r\n        money price\r\n    }\r\n" +
+		"    type Order struct {\r\n        str id\r\n        money amount\r\n        arr.str tags\r\n" +
+		"        Item item\r\n    }\r\n" +
+		"    func total(Order ord, int n) money {\r\n        return ord.amount + ord.item.price * money(n)\r\n    }\r\n" +
+		"    func newItem(str name) Item {\r\n        return Item{name: name, price: money(7)}\r\n    }\r\n" +
+		"    Order o = Order{id: `x`, amount: money(5)}\r\n    o.item = newItem(`pen`)\r\n" +
+		"    arr.str tags = o.tags\r\n    tags += `new`\r\n    str label = o.item.name\r\n    label += `!`\r\n" +
+		"    o.item.name = `book`\r\n    Order p = o\r\n    p.id = `y`\r\n" +
+		"    return o.id + ` ` + str(total(o, count)) + ` ` + label + ` ` + o.item.name + ` ` + Join(o.tags, `,`) +\r\n" +
+		"        ` ` + order.id + ` ` + str(order.amount) + ` ` + Join(order.tags, `-`) + ` ` + order.item.name\r\n}"
+	if err := vm.LoadContract(source, 0); err != nil {
+		t.Fatal(err)
+	}
+	data := paramsData{`count`: `2`,
+		`order`: `{"id": "o1", "amount": "12", "tags": ["a", "b"], "item": {"name": "cup"}}`}
+	result, _, err := vm.RunByName(`structMain`, data)
+	if err != nil || result != `y 19 pen! book new o1 12 a-b cup` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+	cnt := vm.Contracts[vm.NameSpace[`structMain`]]
+	abi := cnt.ABI()
+	if abi.Params[0].Type != `Order` || abi.Funcs[1].Result != `Item` || len(abi.Structs) != 2 ||
+		abi.Structs[1].Fields[3].Type != `Item` {
+		t.Errorf("wrong abi %v", abi)
+	}
+	if err = abi.CheckParams(data); err != nil {
+		t.Error(err)
+	}
+	data[`order`] = `{"id": "o1", "price": "12"}`
+	if err = abi.CheckParams(data); err == nil || err.Error() != `Struct Order doesn't have price field` {
+		t.Errorf("wrong error %v", err)
+	}
+	if _, _, err = vm.RunByName(`structMain`, data); err == nil ||
+		err.Error() != `Struct Order doesn't have price field` {
+		t.Errorf("wrong error %v", err)
+	}
+	if err := vm.LoadContract("contract structRet {\r\n    type Point struct {\r\n        int x y\r\n    }\r\n"+
+		"    return Point{x: 1, y: 2}\r\n}", 0); err != nil {
+		t.Fatal(err)
+	}
+	if result, _, err = vm.RunByName(`structRet`, newData()); err != nil || result != `{x: 1 y: 2}` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+	out, err := parser.FormatSource(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{`type Order struct {`, `arr.str tags`, `Item item`,
+		`func total(Order ord, int n) money {`, `o.item.name = "book"`,
+		`Order o = Order{id: "x", amount: money(5)}`} {
+		if !strings.Contains(out, line) {
+			t.Errorf("wrong format %s", out)
+		}
+	}
+	for _, item := range []struct {
+		Source string
+		Err    string
+	}{
+		{"contract structWrong {\r\n    type point struct {\r\n        int x\r\n    }\r\n}",
+			`structWrong 2:5: Struct point must start with a capital letter`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        int x\r\n    }\r\n" +
+			"    type Point struct {\r\n        int y\r\n    }\r\n}",
+			`structWrong 5:5: Struct Point has already been defined`},
+		{"contract structWrong {\r\n    Point p\r\n}",
+			`structWrong 2:11: Struct Point hasn't been defined`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        int x\r\n        str x\r\n    }\r\n}",
+			`structWrong 4:9: Field x has already been defined`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        Line l\r\n    }\r\n" +
+			"    type Line struct {\r\n        int x\r\n    }\r\n}",
+			`structWrong 3:9: Struct Line hasn't been defined`},
+		{"contract structWrong {\r\n    if true {\r\n        type Point struct {\r\n            int x\r\n" +
+			"        }\r\n    }\r\n}", `structWrong 3:9: Struct must be defined at the top level of the contract`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        int x\r\n    }\r\n" +
+			"    Point p\r\n    p.y = 1\r\n}", `structWrong 6:9: Struct Point doesn't have y field`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        int x\r\n    }\r\n" +
+			"    Point p\r\n    p.x = `1`\r\n}", `structWrong 6:11: Field x must have int type`},
+		{"contract structWrong {\r\n    type Point struct {\r\n        int x\r\n    }\r\n" +
+			"    return Point{z: 1}\r\n}", `structWrong 5:21: Struct Point doesn't have z field`},
+		{"contract structWrong {\r\n    int i\r\n    return i.x\r\n}",
+			`structWrong 3:12: Type int doesn't have fields`},
+	} {
+		if err := vm.LoadContract(item.Source, 0); err == nil || err.Error() != item.Err {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+}
@@ -0,0 +1,52 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/shelmesky/bvm/runtime"
+)
+
+func TestTry(t *testing.T) {
+	vm := newVM(false)
+	for i, source := range []string{
+		"contract tryCallee {\r\n    data {\r\n        int a\r\n    }\r\n" +
+			"    if a > 1 {\r\n        error(`E_BIG`, `a is too big`)\r\n    }\r\n    return str(10 / a)\r\n}",
+		"contract tryCaller {\r\n    data {\r\n        int a\r\n    }\r\n    str out\r\n" +
+			"    func div(int x) int {\r\n        try {\r\n            return 10 / x\r\n" +
+			"        } catch e {\r\n            out += e[`origin`] + `;`\r\n        }\r\n        return -1\r\n    }\r\n" +
+			"    int i\r\n    while i < 3 {\r\n        i += 1\r\n        try {\r\n            if i == 2 {\r\n" +
+			"                continue\r\n            }\r\n            out += @tryCallee(a: a * i) + `;`\r\n" +
+			"        } catch err {\r\n            out += err[`code`] + `:` + err[`message`] + `:` + err[`origin`] + `;`\r\n" +
+			"        }\r\n    }\r\n    try {\r\n        voidFunc(`x`)\r\n    } catch ec {\r\n" +
+			"        out += ec[`message`] + `:` + ec[`origin`] + `;`\r\n    }\r\n" +
+			"    out += str(div(0)) + str(div(5))\r\n    return out\r\n}",
+		"contract tryError {\r\n    error(`E_AUTH`, `access denied`)\r\n}",
+	} {
+		if err := vm.LoadContract(source, int64(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	result, _, err := vm.RunByName(`tryCaller`, paramsData{`a`: `1`})
+	if err != nil || result != `10;E_BIG:a is too big:contract;errorVoidFunc:custom;stdlib;-12` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+	result, _, err = vm.RunByName(`tryCaller`, paramsData{`a`: `0`})
+	if err != nil || result != `:dividing by zero:contract;:dividing by zero:contract;errorVoidFunc:custom;stdlib;-12` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+	_, _, err = vm.RunByName(`tryError`, newData())
+	if e, ok := err.(*runtime.Error); !ok || e.Code != `E_AUTH` || e.Message != `access denied` ||
+		e.Origin != runtime.OriginError || err.Error() != `E_AUTH: access denied` {
+		t.Errorf("wrong error %v", err)
+	}
+	// the gas of the failed call is charged
+	_, gasFail, _ := vm.RunByName(`tryCallee`, paramsData{`a`: `0`})
+	_, gasOK, _ := vm.RunByName(`tryCallee`, paramsData{`a`: `1`})
+	if gasFail == 0 || gasFail > gasOK {
+		t.Errorf("wrong gas %d %d", gasFail, gasOK)
+	}
+	if err = vm.LoadContract("contract tryWrong {\r\n    error(`code`)\r\n}", 0); err == nil ||
+		err.Error() != `tryWrong 2:17: error requires code and message of str type` {
+		t.Errorf("wrong error %v", err)
+	}
+}
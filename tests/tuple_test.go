@@ -0,0 +1,68 @@
+package test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/shelmesky/bvm/parser"
+)
+
+func TestTuple(t *testing.T) {
+	vm := newVM(false)
+	source := "contract tupleMain {\r\n    data {\r\n        int a\r\n    }\r\n" +
+		"    func divmod(int x y) (int, int) {\r\n        return x / y, x % y\r\n    }\r\n" +
+		"    func split(str s) (str, str, bool) {\r\n        if Len(s) < 2 {\r\n" +
+		"            return s, ``, false\r\n        }\r\n        return Substr(s, 0, 1), Substr(s, 1, Len(s)-1), true\r\n    }\r\n" +
+		"    int q r\r\n    q, r = divmod(a, 3)\r\n    str head tail\r\n    bool ok\r\n" +
+		"    head, tail, ok = split(`bvm`)\r\n    tail += `!`\r\n" +
+		"    return str(q) + ` ` + str(r) + ` ` + head + ` ` + tail + ?(ok, ` ok`, ``)\r\n}"
+	if err := vm.LoadContract(source, 0); err != nil {
+		t.Fatal(err)
+	}
+	result, _, err := vm.RunByName(`tupleMain`, paramsData{`a`: `14`})
+	if err != nil || result != `4 2 b vm! ok` {
+		t.Errorf("wrong result %s %v", result, err)
+	}
+	abi := vm.Contracts[vm.NameSpace[`tupleMain`]].ABI()
+	if len(abi.Funcs) != 2 || len(abi.Funcs[1].Results) != 3 || abi.Funcs[1].Results[2] != `bool` ||
+		abi.Funcs[0].Result != `` {
+		t.Errorf("wrong abi %v", abi.Funcs)
+	}
+	out, err := parser.FormatSource(source)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, line := range []string{`func divmod(int x y) (int, int) {`, `return x / y, x % y`,
+		`q, r = divmod(a, 3)`} {
+		if !strings.Contains(out, line) {
+			t.Errorf("wrong format %s", out)
+		}
+	}
+	for _, item := range []struct {
+		Source string
+		Err    string
+	}{
+		{"contract tupleWrong {\r\n    func f() (int, str) {\r\n        return 1\r\n    }\r\n}",
+			`tupleWrong 3:18: Function must return 2 values`},
+		{"contract tupleWrong {\r\n    func f() (int, str) {\r\n        return 1, 2\r\n    }\r\n}",
+			`tupleWrong 3:19: Function must return (int, str)`},
+		{"contract tupleWrong {\r\n    func f() int {\r\n        return 1, 2\r\n    }\r\n}",
+			`tupleWrong 3:21: Function must return int`},
+		{"contract tupleWrong {\r\n    return 1, 2\r\n}",
+			`tupleWrong 2:17: Contract can return only one value`},
+		{"contract tupleWrong {\r\n    func f() (int, int) {\r\n    }\r\n}",
+			`tupleWrong 3:5: Function must return a value`},
+		{"contract tupleWrong {\r\n    func f() (int, int) {\r\n        return 1, 2\r\n    }\r\n" +
+			"    int a = f()\r\n}", `tupleWrong 5:15: Function f returns multiple values`},
+		{"contract tupleWrong {\r\n    func f() (int, int) {\r\n        return 1, 2\r\n    }\r\n" +
+			"    int a b c\r\n    a, b, c = f()\r\n}", `tupleWrong 6:19: Assignment of 3 variables but f returns 2 values`},
+		{"contract tupleWrong {\r\n    func f() (int, int) {\r\n        return 1, 2\r\n    }\r\n" +
+			"    int a\r\n    str b\r\n    a, b = f()\r\n}", `tupleWrong 7:8: Variable b must have int type`},
+		{"contract tupleWrong {\r\n    int a b\r\n    a, b = 1\r\n}",
+			`tupleWrong 3:12: Multiple assignment requires the function call`},
+	} {
+		if err := vm.LoadContract(item.Source, 0); err == nil || err.Error() != item.Err {
+			t.Errorf("wrong error %v", err)
+		}
+	}
+}
@@ -215,3 +215,34 @@ func (m *Map) MarshalJSON() ([]byte, error) {
 	s = s + "}"
 	return []byte(s), nil
 }
+
+// Equal returns true if the values of obj type are equal. The maps are equal if they have
+// the same keys and values regardless of the insertion order.
+func Equal(a, b interface{}) bool {
+	switch va := a.(type) {
+	case *Map:
+		vb, ok := b.(*Map)
+		if !ok || va.Size() != vb.Size() {
+			return false
+		}
+		for current := va.head; current != nil; current = current.next {
+			link, found := vb.m[current.key]
+			if !found || !Equal(current.value, link.value) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		vb, ok := b.([]interface{})
+		if !ok || len(va) != len(vb) {
+			return false
+		}
+		for i := range va {
+			if !Equal(va[i], vb[i]) {
+				return false
+			}
+		}
+		return true
+	}
+	return a == b
+}
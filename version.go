@@ -0,0 +1,167 @@
+package simvolio
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/shelmesky/bvm/parser"
+	"github.com/shelmesky/bvm/runtime"
+)
+
+const (
+	errCntVersion = `Contract %s doesn't have version %d`
+	errCntUnlink  = `Contract %s cannot be unlinked, it is called by %s`
+)
+
+// contractVersion is the version of the linked contract. The pinned calls like @Token.v2()
+// use the separate index of the version in vm.Contracts which is never replaced, so the
+// index is created for the versions which are called by the pinned calls only.
+type contractVersion struct {
+	Contract *runtime.Contract
+	Source   contractFile
+}
+
+// addVersion saves the linked contract as the next version
+func (vm *VM) addVersion(cnt *runtime.Contract) {
+	if vm.versions == nil {
+		vm.versions = make(map[string][]*contractVersion)
+	}
+	cnt.Version = len(vm.versions[cnt.Name]) + 1
+	vm.versions[cnt.Name] = append(vm.versions[cnt.Name], &contractVersion{Contract: cnt})
+}
+
+// version returns the version of the contract or nil
+func (vm *VM) version(name string, version int) *contractVersion {
+	list := vm.versions[name]
+	if version < 1 || version > len(list) {
+		return nil
+	}
+	return list[version-1]
+}
+
+// setSource saves the source of the current version of the contract
+func (vm *VM) setSource(name, file, text string) {
+	if cnt := vm.GetContract(name); cnt != nil {
+		if item := vm.version(name, cnt.Version); item != nil {
+			item.Source = contractFile{File: file, Text: text}
+		}
+	}
+}
+
+// librarySource keeps the original source of the library. The importing contracts compile
+// the functions of the library from it.
+func librarySource(cnt *runtime.Contract, text string) {
+	if cnt.Library {
+		cnt.Source = text
+	}
+}
+
+// replace replaces the version of the contract with the recompiled one
+func (vm *VM) replace(cnt *runtime.Contract) {
+	item := vm.version(cnt.Name, cnt.Version)
+	if item == nil {
+		return
+	}
+	for i, old := range vm.Contracts {
+		if old == item.Contract {
+			vm.Contracts[i] = cnt
+		}
+	}
+	item.Contract = cnt
+}
+
+// pin links the versions of the contracts which are called by the pinned calls. It returns
+// the names of the linked versions.
+func (vm *VM) pin(root *parser.Node) (names []string) {
+	parser.Inspect(root, func(node *parser.Node) bool {
+		if node == nil {
+			return false
+		}
+		if node.Type == parser.TCallContract {
+			nCall := node.Value.(*parser.NCallContract)
+			name := parser.VersionName(nCall.Name, nCall.Version)
+			if _, ok := vm.NameSpace[name]; !ok && nCall.Version > 0 {
+				if item := vm.version(nCall.Name, nCall.Version); item != nil {
+					vm.Contracts = append(vm.Contracts, item.Contract)
+					vm.NameSpace[name] = uint32(len(vm.Contracts) - 1)
+					names = append(names, name)
+				}
+			}
+		}
+		return true
+	})
+	return names
+}
+
+// unpin removes the pinned versions which have been appended after the count contracts. It is
+// called if the contract which pins them has not been compiled.
+func (vm *VM) unpin(count int, names []string) {
+	for _, name := range names {
+		delete(vm.NameSpace, name)
+	}
+	vm.Contracts = vm.Contracts[:count]
+}
+
+// Versions returns all versions of the contract
+func (vm *VM) Versions(name string) []*runtime.Contract {
+	ret := make([]*runtime.Contract, len(vm.versions[name]))
+	for i, item := range vm.versions[name] {
+		ret[i] = item.Contract
+	}
+	return ret
+}
+
+// Rollback makes the version of the contract current. The dependent contracts are recompiled
+// if the parameters of the versions are different.
+func (vm *VM) Rollback(name string, version int) error {
+	ind, ok := vm.NameSpace[name]
+	if !ok {
+		return fmt.Errorf(errCntNotExists, name)
+	}
+	item := vm.version(name, version)
+	if item == nil {
+		return fmt.Errorf(errCntVersion, name, version)
+	}
+	if vm.Contracts[ind] == item.Contract {
+		return nil
+	}
+	return vm.relink(ind, item.Contract)
+}
+
+// Unlink removes the contract and all its versions from VM. The contract cannot be removed
+// while other contracts call it.
+func (vm *VM) Unlink(name string) error {
+	ind, ok := vm.NameSpace[name]
+	if !ok {
+		return fmt.Errorf(errCntNotExists, name)
+	}
+	var callers []string
+	used := make(map[string]bool)
+	for _, cnt := range vm.Contracts {
+		if cnt == nil || cnt.Name == name || used[cnt.Name] {
+			continue
+		}
+		for _, call := range cnt.Calls {
+			if call == name || strings.HasPrefix(call, name+`.v`) {
+				used[cnt.Name] = true
+				callers = append(callers, cnt.Name)
+				break
+			}
+		}
+	}
+	if len(callers) > 0 {
+		return fmt.Errorf(errCntUnlink, name, strings.Join(callers, `, `))
+	}
+	// the indexes are compiled into the bytecode so the contracts are not shifted
+	vm.Contracts[ind] = nil
+	delete(vm.NameSpace, name)
+	for i := range vm.versions[name] {
+		key := parser.VersionName(name, i+1)
+		if pinned, ok := vm.NameSpace[key]; ok {
+			vm.Contracts[pinned] = nil
+			delete(vm.NameSpace, key)
+		}
+	}
+	delete(vm.versions, name)
+	return nil
+}
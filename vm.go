@@ -19,6 +19,7 @@ const (
 
 	errCntExists    = `Contract %s has already been defined`
 	errCntNotExists = `Contract %s doesn't exists`
+	errCntLibrary   = `Library %s cannot be executed`
 	errGlobVar      = `Wrong count of global variables`
 	errGlobType     = `%s has unsupported type`
 )
@@ -43,6 +44,7 @@ type FuncItem struct {
 	Params []uint32
 	Result uint32
 	Read   bool
+	Gas    int64 // the maximum gas of the function for the static estimation, 0 if it is unknown
 	Func   interface{}
 }
 
@@ -52,9 +54,11 @@ type EnvItem struct {
 }
 
 type VMSettings struct {
-	Funcs    []FuncItem
-	Env      []EnvItem
-	GasLimit int64
+	Funcs      []FuncItem
+	Env        []EnvItem
+	GasLimit   int64
+	Optimize   bool // optimize the bytecode of compiled contracts
+	CheckedInt bool // integer overflow raises the error instead of wrapping around
 }
 
 // VM is a virtual machine structure
@@ -63,6 +67,7 @@ type VM struct {
 	NameSpace map[string]uint32 // common namespace
 	Settings  VMSettings
 	Custom    *runtime.Custom
+	versions  map[string][]*contractVersion // the versions of the linked contracts
 }
 
 // NewVM creates a new virtual machine
@@ -84,6 +89,7 @@ func NewVM(settings VMSettings) *VM {
 			Params: val.Params,
 			Name:   val.Name,
 			Read:   val.Read,
+			Gas:    val.Gas,
 			Func:   val.Func,
 		}
 	}
@@ -92,16 +98,57 @@ func NewVM(settings VMSettings) *VM {
 		Contracts: make([]*runtime.Contract, 0, 1000),
 		NameSpace: make(map[string]uint32),
 		Settings:  settings,
+		versions:  make(map[string][]*contractVersion),
 		Custom: &runtime.Custom{
-			Env:   env,
-			Funcs: funcs,
+			Env:      env,
+			Funcs:    funcs,
+			Optimize: settings.Optimize,
 		},
 	}
 }
 
 // Compile compiles the contract and returns its structure
 func (vm *VM) Compile(input string) (cnt *runtime.Contract, err error) {
-	return compiler.Compile(input, &vm.NameSpace, &vm.Contracts, vm.Custom)
+	root, err := parser.Parser(input)
+	if err != nil {
+		return nil, err
+	}
+	count := len(vm.Contracts)
+	pinned := vm.pin(root)
+	if cnt, err = compiler.CompileNode(root, &vm.NameSpace, &vm.Contracts, vm.Custom); err != nil {
+		vm.unpin(count, pinned)
+		return nil, err
+	}
+	librarySource(cnt, input)
+	return cnt, nil
+}
+
+// Analyze compiles the contract and returns the warnings of the static analyzer
+func (vm *VM) Analyze(input string) ([]compiler.Warning, error) {
+	_, warnings, err := vm.AnalyzeContract(input)
+	return warnings, err
+}
+
+// AnalyzeContract compiles the contract and returns it with the warnings of the static
+// analyzer. The contract is not linked.
+func (vm *VM) AnalyzeContract(input string) (*runtime.Contract, []compiler.Warning, error) {
+	count := len(vm.Contracts)
+	var pinned []string
+	if root, err := parser.Parser(input); err == nil {
+		pinned = vm.pin(root)
+	}
+	cnt, warnings, err := compiler.AnalyzeContract(input, &vm.NameSpace, &vm.Contracts, vm.Custom)
+	if err != nil {
+		vm.unpin(count, pinned)
+		return nil, nil, err
+	}
+	librarySource(cnt, input)
+	return cnt, warnings, nil
+}
+
+// EstimateGas returns the upper bound of gas which can be used by the contract
+func (vm *VM) EstimateGas(cnt *runtime.Contract) compiler.GasEstimate {
+	return compiler.EstimateGas(cnt, vm.Contracts, vm.Custom)
 }
 
 // GetContract returns the contract by its name
@@ -112,7 +159,9 @@ func (vm *VM) GetContract(name string) *runtime.Contract {
 	return nil
 }
 
-// Link links the compiled contract to VM
+// Link links the compiled contract to VM. If reload is true then the contract replaces the
+// linked contract with the same name, the dependent contracts are recompiled if the
+// parameters of the contract have been changed. The linked contract gets the next version.
 // 将编译的好的contract链接到VM
 func (vm *VM) Link(cnt *runtime.Contract, reload bool) error {
 	var (
@@ -125,12 +174,25 @@ func (vm *VM) Link(cnt *runtime.Contract, reload bool) error {
 		return fmt.Errorf(errCntNotExists, cnt.Name)
 	}
 	if reload {
-		vm.Contracts[ind] = cnt
+		if err := vm.relink(ind, cnt); err != nil {
+			return err
+		}
 	} else {
 		vm.Contracts = append(vm.Contracts, cnt) // 在vm.Contracts合约数组中保存合约
 		ind = uint32(len(vm.Contracts) - 1)      // 保存后的索引位置
 	}
 	vm.NameSpace[cnt.Name] = ind // 在vm.NameSpace中保存合约的名字和索引位置
+	vm.addVersion(cnt)
+	return nil
+}
+
+// LinkSource links the compiled contract and saves its source. The source is used to recompile
+// the contract when the contracts which it calls are changed.
+func (vm *VM) LinkSource(cnt *runtime.Contract, reload bool, file, text string) error {
+	if err := vm.Link(cnt, reload); err != nil {
+		return err
+	}
+	vm.setSource(cnt.Name, file, text)
 	return nil
 }
 
@@ -140,16 +202,41 @@ func (vm *VM) LoadContract(input string, id int64) error {
 	if err != nil {
 		return err
 	}
-	if err = vm.Link(cnt, false); err != nil {
+	if err = vm.LinkSource(cnt, false, ``, input); err != nil {
 		return err
 	}
 	cnt.ID = id
 	return nil
 }
 
+// ReloadContract compiles the contract and replaces the linked contract with the same name
+func (vm *VM) ReloadContract(input string) error {
+	cnt, err := vm.Compile(input)
+	if err != nil {
+		return err
+	}
+	return vm.LinkSource(cnt, true, ``, input)
+}
+
 // Run executes the contract
 func (vm *VM) Run(cnt *runtime.Contract, data runtime.IData) (string, int64, error) {
+	return vm.run(cnt, data, false)
+}
+
+// Validate checks the parameters and executes only the conditions of the contract. The
+// conditions cannot change the state so Validate can be called before Run.
+func (vm *VM) Validate(cnt *runtime.Contract, data runtime.IData) (int64, error) {
+	_, gas, err := vm.run(cnt, data, true)
+	return gas, err
+}
+
+func (vm *VM) run(cnt *runtime.Contract, data runtime.IData, validate bool) (string, int64, error) {
+	if cnt.Library {
+		return ``, 0, fmt.Errorf(errCntLibrary, cnt.Name)
+	}
 	rt := runtime.NewRuntime(&vm.Contracts)
+	rt.Validate = validate
+	rt.Checked = vm.Settings.CheckedInt
 	env := make([]runtime.EnvVal, len(vm.Custom.Env))
 	envData := data.GetEnv()
 	if len(envData) != len(vm.Custom.Env) {
@@ -193,7 +280,11 @@ func (vm *VM) Run(cnt *runtime.Contract, data runtime.IData) (string, int64, err
 			val int64
 			v   interface{}
 		)
+		attr := cnt.Attrs[key]
 		if v = data.GetParam(key); v == nil {
+			if attr != nil && attr.Required {
+				return ``, 0, attr.Check(key, vi.Type, v)
+			}
 			continue
 		}
 		switch vVal := v.(type) {
@@ -222,7 +313,11 @@ func (vm *VM) Run(cnt *runtime.Contract, data runtime.IData) (string, int64, err
 				if err != nil {
 					return ``, 0, err
 				}
-				rt.Objects = append(rt.Objects, d.Floor())
+				var places int32
+				if attr != nil {
+					places = int32(attr.Places)
+				}
+				rt.Objects = append(rt.Objects, runtime.ScaleMoney(d, places))
 				val = int64(len(rt.Objects) - 1)
 			case parser.VBytes:
 				var b []byte
@@ -232,7 +327,13 @@ func (vm *VM) Run(cnt *runtime.Contract, data runtime.IData) (string, int64, err
 					val = int64(len(rt.Objects) - 1)
 				}
 			default:
-				return ``, 0, fmt.Errorf(`Unsupported type of parameter`)
+				if !runtime.IsStruct(int64(vi.Type)) {
+					return ``, 0, fmt.Errorf(`Unsupported type of parameter`)
+				}
+				// the struct is passed as json object
+				if val, err = rt.StructParam(cnt, int64(vi.Type), key, vVal); err != nil {
+					return ``, 0, err
+				}
 			}
 		case []byte:
 			switch vi.Type {
@@ -253,12 +354,18 @@ func (vm *VM) Run(cnt *runtime.Contract, data runtime.IData) (string, int64, err
 		default:
 			err = fmt.Errorf(`Params must have string or []bytes type`)
 		}
+		if err == nil && attr != nil {
+			err = attr.Check(key, vi.Type, v)
+		}
 		if err != nil {
 			return ``, 0, err
 		}
 		params = append(params, int64(vi.Index))
 		params = append(params, val)
 	}
+	if validate && !cnt.Conditions {
+		return ``, 0, nil
+	}
 	return rt.Run(cnt, cnt.Code, params, vm.Settings.GasLimit)
 }
 